@@ -0,0 +1,88 @@
+package blikk
+
+import "github.com/invenconlabs/blikk-sdk/dateutils"
+
+// AbsenceType is the kind of absence an absence project records.
+type AbsenceType string
+
+const (
+	AbsenceTypeVacation          AbsenceType = "Vacation"
+	AbsenceTypeSickLeave         AbsenceType = "SickLeave"
+	AbsenceTypeParentalLeave     AbsenceType = "ParentalLeave"
+	AbsenceTypeCareOfChild       AbsenceType = "CareOfChild"
+	AbsenceTypeLeaveOfAbsence    AbsenceType = "LeaveOfAbsence"
+	AbsenceTypeCompensatoryLeave AbsenceType = "CompensatoryLeave"
+	AbsenceTypeOther             AbsenceType = "Other"
+)
+
+// AbsenceProjects is an absence project as returned when listing absence
+// projects. Absence is reported against absence projects, one per kind of
+// absence.
+type AbsenceProjects struct {
+	ObjectName      string      `json:"objectName"`
+	ID              int         `json:"id"`
+	Name            string      `json:"name"`
+	Code            string      `json:"code"`
+	Type            AbsenceType `json:"type"`
+	IsActive        bool        `json:"isActive"`
+	AffectsTimeBank bool        `json:"affectsTimeBank"`
+	SalaryCode      string      `json:"salaryCode"`
+	CreatedDate     string      `json:"createdDate"`
+	UpdatedDate     string      `json:"updatedDate"`
+}
+
+func (AbsenceProjects) path(ReferenceDataFilter) string {
+	return "v1/Core/AbsenceProjects"
+}
+
+type AbsenceProject AbsenceProjects
+
+func (AbsenceProject) path(query string) string {
+	return "v1/Core/AbsenceProjects/" + query
+}
+
+// AbsenceReports is a reported absence for a user on a single day.
+type AbsenceReports struct {
+	ObjectName     string             `json:"objectName"`
+	ID             int                `json:"id"`
+	Date           dateutils.DateOnly `json:"date"`
+	Hours          float64            `json:"hours"`
+	Percent        float64            `json:"percent"`
+	Comment        string             `json:"comment"`
+	User           blikkObject        `json:"user"`
+	AbsenceProject struct {
+		blikkObject
+		Type AbsenceType `json:"type"`
+	} `json:"absenceProject"`
+	TimeReportID int         `json:"timeReportId"`
+	AttestedDate string      `json:"attestedDate"`
+	CreatedBy    blikkObject `json:"createdBy"`
+	UpdatedBy    blikkObject `json:"updatedBy"`
+	CreatedDate  string      `json:"createdDate"`
+	UpdatedDate  string      `json:"updatedDate"`
+}
+
+func (AbsenceReports) path(AbsenceReportFilter) string {
+	return "v1/Core/AbsenceReports"
+}
+
+// AbsenceReportFilter filters the reports returned when listing
+// AbsenceReports.
+type AbsenceReportFilter struct {
+	Pagination
+	UserIDs           []int               `paramName:"filter.userIds"`
+	DepartmentIDs     []int               `paramName:"filter.departmentIds"`
+	FromDate          *dateutils.DateOnly `paramName:"filter.from"`
+	ToDate            *dateutils.DateOnly `paramName:"filter.to"`
+	AbsenceProjectIDs []int               `paramName:"filter.absenceProjectIds"`
+	Type              AbsenceType         `paramName:"filter.type"`
+}
+
+func (f AbsenceReportFilter) Validate() error {
+	violations := f.Pagination.violations()
+	violations = append(violations, dateRangeViolations(f.FromDate, f.ToDate, 0)...)
+	violations = append(violations, unsupportedValueViolations("Type", f.Type,
+		AbsenceTypeVacation, AbsenceTypeSickLeave, AbsenceTypeParentalLeave, AbsenceTypeCareOfChild,
+		AbsenceTypeLeaveOfAbsence, AbsenceTypeCompensatoryLeave, AbsenceTypeOther)...)
+	return newFilterError(f, violations)
+}
@@ -0,0 +1,66 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList_AbsenceReportsFilter(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/AbsenceReports", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "7,8", q.Get("filter.userIds"))
+		assert.Equal(t, "2024-07-01", q.Get("filter.from"))
+		assert.Equal(t, "2024-07-31", q.Get("filter.to"))
+		assert.Equal(t, "Vacation", q.Get("filter.type"))
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": [
+			{"id": 1, "date": "2024-07-15", "hours": 8, "user": {"id": 7}, "absenceProject": {"id": 2, "name": "Semester", "type": "Vacation"}}
+		]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	from, to := FirstDayOfMonth(2024, time.July), LastDayOfMonth(2024, time.July)
+	reports, err := List[AbsenceReports](context.Background(), client, AbsenceReportFilter{
+		UserIDs:  []int{7, 8},
+		FromDate: &from,
+		ToDate:   &to,
+		Type:     AbsenceTypeVacation,
+	})
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, 8.0, reports[0].Hours)
+	assert.Equal(t, AbsenceTypeVacation, reports[0].AbsenceProject.Type)
+}
+
+func TestAbsenceReportFilter_ValidateType(t *testing.T) {
+	err := AbsenceReportFilter{Type: "Holiday"}.Validate()
+
+	var filterErr *FilterError
+	require.ErrorAs(t, err, &filterErr)
+	assert.Equal(t, "Type", filterErr.Violations[0].Field)
+	assert.Equal(t, RuleUnsupported, filterErr.Violations[0].Rule)
+}
+
+func TestList_AbsenceProjects(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/AbsenceProjects", r.URL.Path)
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": [{"id": 3, "name": "Sjukfrånvaro", "type": "SickLeave", "salaryCode": "720"}]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	projects, err := List[AbsenceProjects](context.Background(), client, ReferenceDataFilter{})
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	assert.Equal(t, AbsenceTypeSickLeave, projects[0].Type)
+	assert.Equal(t, "720", projects[0].SalaryCode)
+}
@@ -0,0 +1,240 @@
+package blikk
+
+import (
+	"time"
+
+	"github.com/invenconlabs/blikk-sdk/dateutils"
+)
+
+// Articles is an article as returned when listing the article catalogue.
+// Price is the sales price and PurchasePrice the cost per Unit, both
+// excluding VAT.
+type Articles struct {
+	ObjectName    string      `json:"objectName"`
+	ID            int         `json:"id"`
+	ArticleNumber string      `json:"articleNumber"`
+	Name          string      `json:"name"`
+	Unit          string      `json:"unit"`
+	Price         float64     `json:"price"`
+	PurchasePrice float64     `json:"purchasePrice"`
+	VATPercent    float64     `json:"vatPercent"`
+	IsActive      bool        `json:"isActive"`
+	Supplier      blikkObject `json:"supplier"`
+	CreatedDate   string      `json:"createdDate"`
+	UpdatedDate   string      `json:"updatedDate"`
+}
+
+func (Articles) path(ArticleFilter) string {
+	return "v1/Core/Articles"
+}
+
+// ArticleFilter filters the articles returned when listing Articles.
+type ArticleFilter struct {
+	Pagination
+	// Query matches articles by free text, such as name or article number.
+	Query        string     `paramName:"filter.query"`
+	IsActive     *bool      `paramName:"filter.isActive"`
+	SupplierIDs  []int      `paramName:"filter.supplierIds"`
+	UpdatedSince *time.Time `paramName:"filter.updatedSince"`
+}
+
+func (f ArticleFilter) Validate() error {
+	return newFilterError(f, f.Pagination.violations())
+}
+
+type Article struct {
+	ObjectName          string      `json:"objectName"`
+	ID                  int         `json:"id"`
+	ArticleNumber       string      `json:"articleNumber"`
+	Name                string      `json:"name"`
+	Description         string      `json:"description"`
+	Unit                string      `json:"unit"`
+	Price               float64     `json:"price"`
+	PurchasePrice       float64     `json:"purchasePrice"`
+	VATPercent          float64     `json:"vatPercent"`
+	IsActive            bool        `json:"isActive"`
+	Supplier            blikkObject `json:"supplier"`
+	SupplierArticleCode string      `json:"supplierArticleCode"`
+	SalesAccount        string      `json:"salesAccount"`
+	CreatedBy           blikkObject `json:"createdBy"`
+	UpdatedBy           blikkObject `json:"updatedBy"`
+	CreatedDate         string      `json:"createdDate"`
+	UpdatedDate         string      `json:"updatedDate"`
+}
+
+func (Article) path(query string) string {
+	return "v1/Core/Articles/" + query
+}
+
+func (Article) createPath(ArticleRequest) string {
+	return "v1/Core/Articles"
+}
+
+// ArticleRequest is the request body for creating an article.
+type ArticleRequest struct {
+	ArticleNumber       string   `json:"articleNumber"`
+	Name                string   `json:"name"`
+	Description         string   `json:"description,omitempty"`
+	Unit                string   `json:"unit,omitempty"`
+	Price               *float64 `json:"price,omitempty"`
+	PurchasePrice       *float64 `json:"purchasePrice,omitempty"`
+	VATPercent          *float64 `json:"vatPercent,omitempty"`
+	SupplierID          int      `json:"supplierId,omitempty"`
+	SupplierArticleCode string   `json:"supplierArticleCode,omitempty"`
+	SalesAccount        string   `json:"salesAccount,omitempty"`
+}
+
+type PriceLists struct {
+	ObjectName  string `json:"objectName"`
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Code        string `json:"code"`
+	Currency    string `json:"currency"`
+	IsDefault   bool   `json:"isDefault"`
+	IsActive    bool   `json:"isActive"`
+	CreatedDate string `json:"createdDate"`
+	UpdatedDate string `json:"updatedDate"`
+}
+
+func (PriceLists) path(ReferenceDataFilter) string {
+	return "v1/Core/PriceLists"
+}
+
+// PriceListRow is the price of an article in a price list.
+type PriceListRow struct {
+	ObjectName      string   `json:"objectName"`
+	Article         Articles `json:"article"`
+	Price           float64  `json:"price"`
+	DiscountPercent float64  `json:"discountPercent"`
+}
+
+// PriceList is the full detail of a single price list, including its rows.
+type PriceList struct {
+	ObjectName  string         `json:"objectName"`
+	ID          int            `json:"id"`
+	Name        string         `json:"name"`
+	Code        string         `json:"code"`
+	Currency    string         `json:"currency"`
+	IsDefault   bool           `json:"isDefault"`
+	IsActive    bool           `json:"isActive"`
+	Rows        []PriceListRow `json:"rows"`
+	CreatedBy   blikkObject    `json:"createdBy"`
+	UpdatedBy   blikkObject    `json:"updatedBy"`
+	CreatedDate string         `json:"createdDate"`
+	UpdatedDate string         `json:"updatedDate"`
+}
+
+func (PriceList) path(query string) string {
+	return "v1/Core/PriceLists/" + query
+}
+
+func (PriceList) createPath(PriceListRequest) string {
+	return "v1/Core/PriceLists"
+}
+
+// PriceListRequest is the request body for creating a price list.
+type PriceListRequest struct {
+	Name      string                `json:"name"`
+	Code      string                `json:"code,omitempty"`
+	Currency  string                `json:"currency,omitempty"`
+	IsDefault bool                  `json:"isDefault"`
+	Rows      []PriceListRowRequest `json:"rows,omitempty"`
+}
+
+// PriceListRowRequest sets the price of an article in a PriceListRequest.
+type PriceListRowRequest struct {
+	ArticleID       int      `json:"articleId"`
+	Price           float64  `json:"price"`
+	DiscountPercent *float64 `json:"discountPercent,omitempty"`
+}
+
+// MaterialRowType distinguishes material from other expenses on a project.
+type MaterialRowType string
+
+const (
+	MaterialRowTypeMaterial MaterialRowType = "Material"
+	MaterialRowTypeExpense  MaterialRowType = "Expense"
+)
+
+// MaterialRows is a material or expense row on a project as returned when
+// listing material rows. TotalPrice is the invoiceable amount and TotalCost
+// the purchase cost, both excluding VAT.
+type MaterialRows struct {
+	ObjectName      string             `json:"objectName"`
+	ID              int                `json:"id"`
+	Type            MaterialRowType    `json:"type"`
+	Date            dateutils.DateOnly `json:"date"`
+	Project         ProjectRef         `json:"project"`
+	Article         blikkObject        `json:"article"`
+	ArticleNumber   string             `json:"articleNumber"`
+	Description     string             `json:"description"`
+	Quantity        float64            `json:"quantity"`
+	Unit            string             `json:"unit"`
+	UnitPrice       float64            `json:"unitPrice"`
+	PurchasePrice   float64            `json:"purchasePrice"`
+	DiscountPercent float64            `json:"discountPercent"`
+	TotalPrice      float64            `json:"totalPrice"`
+	TotalCost       float64            `json:"totalCost"`
+	IsInvoiceable   bool               `json:"isInvoiceable"`
+	InvoiceID       int                `json:"invoiceId"`
+	InvoicedDate    string             `json:"invoicedDate"`
+	InvoiceDraftID  int                `json:"invoiceDraftId"`
+	User            blikkObject        `json:"user"`
+	Supplier        blikkObject        `json:"supplier"`
+	CreatedBy       blikkObject        `json:"createdBy"`
+	UpdatedBy       blikkObject        `json:"updatedBy"`
+	CreatedDate     string             `json:"createdDate"`
+	UpdatedDate     string             `json:"updatedDate"`
+}
+
+func (MaterialRows) path(MaterialRowFilter) string {
+	return "v1/Core/MaterialRows"
+}
+
+// MaterialRowFilter filters the rows returned when listing MaterialRows.
+// Nil pointer fields are not filtered on.
+type MaterialRowFilter struct {
+	Pagination
+	ProjectIDs []int               `paramName:"filter.projectIds"`
+	UserIDs    []int               `paramName:"filter.userIds"`
+	ArticleIDs []int               `paramName:"filter.articleIds"`
+	FromDate   *dateutils.DateOnly `paramName:"filter.from"`
+	ToDate     *dateutils.DateOnly `paramName:"filter.to"`
+	Type       MaterialRowType     `paramName:"filter.type"`
+	Invoiced   *bool               `paramName:"filter.isInvoiced"`
+}
+
+func (f MaterialRowFilter) Validate() error {
+	violations := f.Pagination.violations()
+	violations = append(violations, dateRangeViolations(f.FromDate, f.ToDate, 0)...)
+	violations = append(violations, unsupportedValueViolations("Type", f.Type, MaterialRowTypeMaterial, MaterialRowTypeExpense)...)
+	return newFilterError(f, violations)
+}
+
+type MaterialRow MaterialRows
+
+func (MaterialRow) path(query string) string {
+	return "v1/Core/MaterialRows/" + query
+}
+
+func (MaterialRow) createPath(MaterialRowRequest) string {
+	return "v1/Core/MaterialRows"
+}
+
+// MaterialRowRequest is the request body for creating a material or expense
+// row. UnitPrice and PurchasePrice default to the article's prices when nil.
+type MaterialRowRequest struct {
+	Type            MaterialRowType    `json:"type"`
+	Date            dateutils.DateOnly `json:"date"`
+	ProjectID       int                `json:"projectId"`
+	ArticleID       int                `json:"articleId,omitempty"`
+	Description     string             `json:"description,omitempty"`
+	Quantity        float64            `json:"quantity"`
+	Unit            string             `json:"unit,omitempty"`
+	UnitPrice       *float64           `json:"unitPrice,omitempty"`
+	PurchasePrice   *float64           `json:"purchasePrice,omitempty"`
+	DiscountPercent *float64           `json:"discountPercent,omitempty"`
+	IsInvoiceable   *bool              `json:"isInvoiceable,omitempty"`
+	UserID          int                `json:"userId,omitempty"`
+	SupplierID      int                `json:"supplierId,omitempty"`
+}
@@ -0,0 +1,86 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList_MaterialRowsFilter(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/MaterialRows", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "42", q.Get("filter.projectIds"))
+		assert.Equal(t, "Material", q.Get("filter.type"))
+		assert.Equal(t, "false", q.Get("filter.isInvoiced"))
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": [
+			{"id": 8, "type": "Material", "date": "2024-04-02", "quantity": 12, "unit": "st", "unitPrice": 45, "purchasePrice": 30, "totalPrice": 540, "totalCost": 360}
+		]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	invoiced := false
+	rows, err := List[MaterialRows](context.Background(), client, MaterialRowFilter{
+		ProjectIDs: []int{42},
+		Type:       MaterialRowTypeMaterial,
+		Invoiced:   &invoiced,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, 12.0, rows[0].Quantity)
+	assert.Equal(t, 360.0, rows[0].TotalCost)
+}
+
+func TestCreate_MaterialRow(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/Core/MaterialRows", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"type": "Expense", "date": "2024-04-02", "projectId": 42, "description": "Container", "quantity": 1, "unitPrice": 2500}`, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintln(w, `{"id": 9, "type": "Expense", "totalPrice": 2500}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	unitPrice := 2500.0
+	row, err := Create[MaterialRow](context.Background(), client, MaterialRowRequest{
+		Type:        MaterialRowTypeExpense,
+		Date:        DateOnly{Time: time.Date(2024, 4, 2, 0, 0, 0, 0, time.UTC)},
+		ProjectID:   42,
+		Description: "Container",
+		Quantity:    1,
+		UnitPrice:   &unitPrice,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 9, row.ID)
+	assert.Equal(t, 2500.0, row.TotalPrice)
+}
+
+func TestGet_PriceListWithRows(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/PriceLists/2", r.URL.Path)
+		fmt.Fprintln(w, `{"id": 2, "name": "Bygg 2024", "rows": [{"article": {"id": 5, "articleNumber": "A-5", "name": "Gips"}, "price": 89, "discountPercent": 10}]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	priceList, err := Get[PriceList](context.Background(), client, "2")
+	require.NoError(t, err)
+	require.Len(t, priceList.Rows, 1)
+	assert.Equal(t, "A-5", priceList.Rows[0].Article.ArticleNumber)
+	assert.Equal(t, 89.0, priceList.Rows[0].Price)
+}
@@ -0,0 +1,168 @@
+package blikk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Sentinel errors matched by AttestError through errors.Is when at least
+// one day could not be changed for the given reason.
+var (
+	ErrDayLocked   = errors.New("day is locked")
+	ErrDayInvoiced = errors.New("day is invoiced")
+)
+
+// AttestFailureReason explains why a day could not be changed.
+type AttestFailureReason string
+
+const (
+	AttestFailureLocked          AttestFailureReason = "Locked"
+	AttestFailureInvoiced        AttestFailureReason = "Invoiced"
+	AttestFailureAttested        AttestFailureReason = "Attested"
+	AttestFailureNotSentToAttest AttestFailureReason = "NotSentToAttest"
+	AttestFailureNotAttested     AttestFailureReason = "NotAttested"
+	AttestFailureNoTimeReports   AttestFailureReason = "NoTimeReports"
+)
+
+// AttestRequest selects the days an attest or lock operation applies to:
+// every day from FromDate to ToDate, inclusive, for each of UserIDs and for
+// every user in DepartmentIDs.
+type AttestRequest struct {
+	UserIDs       []int    `json:"userIds,omitempty"`
+	DepartmentIDs []int    `json:"departmentIds,omitempty"`
+	FromDate      DateOnly `json:"fromDate"`
+	ToDate        DateOnly `json:"toDate"`
+	Comment       string   `json:"comment,omitempty"`
+}
+
+// Validate checks that the request selects at least one user or department
+// and a date range, and returns a *FilterError listing the violations.
+func (r AttestRequest) Validate() error {
+	var violations []FilterViolation
+	if len(r.UserIDs) == 0 && len(r.DepartmentIDs) == 0 {
+		violations = append(violations, FilterViolation{Field: "UserIDs", Rule: RuleRequired, Allowed: "at least one of UserIDs or DepartmentIDs"})
+	}
+	if r.FromDate.IsZero() {
+		violations = append(violations, FilterViolation{Field: "FromDate", Rule: RuleRequired, Allowed: "a non-zero date"})
+	}
+	if r.ToDate.IsZero() {
+		violations = append(violations, FilterViolation{Field: "ToDate", Rule: RuleRequired, Allowed: "a non-zero date"})
+	}
+	if !r.FromDate.IsZero() && !r.ToDate.IsZero() {
+		violations = append(violations, dateRangeViolations(&r.FromDate, &r.ToDate, 0)...)
+	}
+	return newFilterError(r, violations)
+}
+
+// AttestDayResult is the outcome of an attest or lock operation for one
+// user and day. Reason and Message are set when Succeeded is false.
+type AttestDayResult struct {
+	ObjectName string              `json:"objectName"`
+	UserID     int                 `json:"userId"`
+	Date       DateOnly            `json:"date"`
+	Succeeded  bool                `json:"succeeded"`
+	Reason     AttestFailureReason `json:"reason"`
+	Message    string              `json:"message"`
+}
+
+// AttestResult is the outcome of an attest or lock operation, with one
+// entry per user and day.
+type AttestResult struct {
+	ObjectName string            `json:"objectName"`
+	Days       []AttestDayResult `json:"days"`
+}
+
+// Failed returns the days that could not be changed.
+func (r AttestResult) Failed() []AttestDayResult {
+	var failed []AttestDayResult
+	for _, day := range r.Days {
+		if !day.Succeeded {
+			failed = append(failed, day)
+		}
+	}
+	return failed
+}
+
+// AttestError is returned alongside the AttestResult when some days could
+// not be changed. The other days in the result were changed. Use errors.Is
+// with ErrDayLocked or ErrDayInvoiced to check for the common reasons.
+type AttestError struct {
+	// Operation is the operation that was performed, e.g. "attest".
+	Operation string
+	Failed    []AttestDayResult
+}
+
+func (e *AttestError) Error() string {
+	parts := make([]string, len(e.Failed))
+	for i, day := range e.Failed {
+		parts[i] = fmt.Sprintf("user %d on %s: %s", day.UserID, day.Date.Format(time.DateOnly), day.Reason)
+	}
+	return fmt.Sprintf("failed to %s %d days: %s", e.Operation, len(e.Failed), strings.Join(parts, "; "))
+}
+
+// Is reports whether any failed day matches target, which is ErrDayLocked
+// or ErrDayInvoiced.
+func (e *AttestError) Is(target error) bool {
+	var reason AttestFailureReason
+	switch target {
+	case ErrDayLocked:
+		reason = AttestFailureLocked
+	case ErrDayInvoiced:
+		reason = AttestFailureInvoiced
+	default:
+		return false
+	}
+	for _, day := range e.Failed {
+		if day.Reason == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// SendToAttest sends the selected days to attest.
+func SendToAttest(ctx context.Context, c *Client, req AttestRequest) (AttestResult, error) {
+	return attest(ctx, c, "send to attest", "v1/Core/Attest/SendToAttest", req)
+}
+
+// Attest attests the selected days. Days must have been sent to attest.
+func Attest(ctx context.Context, c *Client, req AttestRequest) (AttestResult, error) {
+	return attest(ctx, c, "attest", "v1/Core/Attest/Attest", req)
+}
+
+// Unattest reverts the attestation of the selected days. Locked and
+// invoiced days cannot be unattested.
+func Unattest(ctx context.Context, c *Client, req AttestRequest) (AttestResult, error) {
+	return attest(ctx, c, "unattest", "v1/Core/Attest/Unattest", req)
+}
+
+// Lock locks the selected days so that their time reports can no longer be
+// changed, for example once they have been exported to payroll.
+func Lock(ctx context.Context, c *Client, req AttestRequest) (AttestResult, error) {
+	return attest(ctx, c, "lock", "v1/Core/Attest/Lock", req)
+}
+
+// Unlock unlocks the selected days.
+func Unlock(ctx context.Context, c *Client, req AttestRequest) (AttestResult, error) {
+	return attest(ctx, c, "unlock", "v1/Core/Attest/Unlock", req)
+}
+
+// attest validates req, performs an attest or lock operation and returns an
+// *AttestError if any day could not be changed.
+func attest(ctx context.Context, c *Client, operation, path string, req AttestRequest) (AttestResult, error) {
+	if err := req.Validate(); err != nil {
+		return AttestResult{}, err
+	}
+	result, err := write[AttestResult](ctx, c, http.MethodPost, path, req)
+	if err != nil {
+		return result, err
+	}
+	if failed := result.Failed(); len(failed) > 0 {
+		return result, &AttestError{Operation: operation, Failed: failed}
+	}
+	return result, nil
+}
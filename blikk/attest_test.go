@@ -0,0 +1,137 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttest_AllDaysSucceeded(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/Core/Attest/Attest", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"userIds": [7], "fromDate": "2024-05-06", "toDate": "2024-05-07"}`, string(body))
+
+		fmt.Fprintln(w, `{"days": [
+			{"userId": 7, "date": "2024-05-06", "succeeded": true},
+			{"userId": 7, "date": "2024-05-07", "succeeded": true}
+		]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	result, err := Attest(context.Background(), client, AttestRequest{
+		UserIDs:  []int{7},
+		FromDate: DateOnly{Time: time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)},
+		ToDate:   DateOnly{Time: time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC)},
+	})
+	require.NoError(t, err)
+	assert.Len(t, result.Days, 2)
+	assert.Empty(t, result.Failed())
+}
+
+func TestUnattest_LockedAndInvoicedDays(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/Attest/Unattest", r.URL.Path)
+		fmt.Fprintln(w, `{"days": [
+			{"userId": 7, "date": "2024-05-06", "succeeded": true},
+			{"userId": 7, "date": "2024-05-07", "succeeded": false, "reason": "Locked", "message": "The day is locked"},
+			{"userId": 8, "date": "2024-05-07", "succeeded": false, "reason": "Invoiced"}
+		]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	result, err := Unattest(context.Background(), client, AttestRequest{
+		DepartmentIDs: []int{2},
+		FromDate:      DateOnly{Time: time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)},
+		ToDate:        DateOnly{Time: time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC)},
+	})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrDayLocked)
+	assert.ErrorIs(t, err, ErrDayInvoiced)
+	assert.EqualError(t, err, "failed to unattest 2 days: user 7 on 2024-05-07: Locked; user 8 on 2024-05-07: Invoiced")
+
+	var attestErr *AttestError
+	require.ErrorAs(t, err, &attestErr)
+	assert.Len(t, attestErr.Failed, 2)
+	assert.Len(t, result.Days, 3)
+}
+
+func TestLock_NotAttestedDay(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/Attest/Lock", r.URL.Path)
+		fmt.Fprintln(w, `{"days": [{"userId": 7, "date": "2024-05-06", "succeeded": false, "reason": "NotAttested"}]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	_, err := Lock(context.Background(), client, AttestRequest{
+		UserIDs:  []int{7},
+		FromDate: DateOnly{Time: time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)},
+		ToDate:   DateOnly{Time: time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)},
+	})
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrDayLocked)
+	assert.NotErrorIs(t, err, ErrDayInvoiced)
+}
+
+func TestAttest_InvalidRequest(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		req        AttestRequest
+		violations []FilterViolation
+	}{
+		{
+			name: "empty request",
+			req:  AttestRequest{},
+			violations: []FilterViolation{
+				{Field: "UserIDs", Rule: RuleRequired, Allowed: "at least one of UserIDs or DepartmentIDs"},
+				{Field: "FromDate", Rule: RuleRequired, Allowed: "a non-zero date"},
+				{Field: "ToDate", Rule: RuleRequired, Allowed: "a non-zero date"},
+			},
+		},
+		{
+			name: "from after to",
+			req: AttestRequest{
+				UserIDs:  []int{7},
+				FromDate: DateOnly{Time: time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC)},
+				ToDate:   DateOnly{Time: time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)},
+			},
+			violations: []FilterViolation{
+				{Field: "FromDate", Rule: RuleFromAfterTo, Allowed: "a date on or before ToDate"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Attest(context.Background(), client, tt.req)
+			require.ErrorIs(t, err, ErrInvalidFilter)
+
+			var filterErr *FilterError
+			require.ErrorAs(t, err, &filterErr)
+			assert.Equal(t, "AttestRequest", filterErr.Filter)
+			assert.Equal(t, tt.violations, filterErr.Violations)
+		})
+	}
+}
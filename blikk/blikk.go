@@ -1,15 +1,16 @@
 package blikk
 
 import (
-	b64 "encoding/base64"
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 	"os"
 	"reflect"
-	"strconv"
 	"strings"
 	"time"
 
@@ -25,11 +26,18 @@ var (
 	LastDayOfMonth  = dateutils.LastDayOfMonth
 )
 
+const (
+	defaultBaseURL  = "https://publicapi.blikk.com/"
+	defaultPageSize = 100
+)
+
 // Client is the main client for interacting with the Blikk API.
 type Client struct {
-	baseURL    string
-	token      string
-	httpClient *http.Client
+	baseURL     string
+	tokenSource TokenSource
+	httpClient  *http.Client
+	retryPolicy RetryPolicy
+	concurrency int
 }
 
 // ClientOption is a function that configures a Client.
@@ -51,12 +59,54 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 	}
 }
 
+// WithTokenSource sets the TokenSource the client uses to authorize requests,
+// replacing the static token passed to NewClient.
+func WithTokenSource(ts TokenSource) ClientOption {
+	return func(c *Client) {
+		c.tokenSource = ts
+	}
+}
+
+// WithCredentials makes the client acquire its own access tokens using the
+// given app ID and secret. Tokens are fetched on first use and refreshed
+// shortly before they expire, so the token passed to NewClient is ignored
+// and may be empty.
+func WithCredentials(appID, appSecret string) ClientOption {
+	return func(c *Client) {
+		c.tokenSource = &credentialsTokenSource{
+			client:    c,
+			appID:     appID,
+			appSecret: appSecret,
+		}
+	}
+}
+
+// WithRetryPolicy sets the policy used to retry requests that fail with a
+// transient error. See DefaultRetryPolicy for the policy used otherwise.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithConcurrency sets the maximum number of requests the client issues in
+// parallel for operations that split their work into several requests, such
+// as fetching the pages of List or the windows of ListUserDayStatistics.
+// The default of 1 issues requests sequentially.
+func WithConcurrency(n int) ClientOption {
+	return func(c *Client) {
+		c.concurrency = max(n, 1)
+	}
+}
+
 // NewClient creates a new Blikk API client.
 func NewClient(token string, opts ...ClientOption) *Client {
 	c := &Client{
-		baseURL:    "https://publicapi.blikk.com/",
-		token:      token,
-		httpClient: &http.Client{Timeout: 30 * time.Second},
+		baseURL:     defaultBaseURL,
+		tokenSource: StaticTokenSource(token),
+		httpClient:  &http.Client{Timeout: 30 * time.Second},
+		retryPolicy: DefaultRetryPolicy(),
+		concurrency: 1,
 	}
 
 	for _, opt := range opts {
@@ -68,80 +118,210 @@ func NewClient(token string, opts ...ClientOption) *Client {
 
 // GetAccessToken retrieves a new access token using the app ID and secret
 // from environment variables (BLIKK_APP_ID, BLIKK_APP_SECRET).
+//
+// The token is not refreshed when it expires; long-running programs should
+// create their client with WithCredentials instead.
 func GetAccessToken() (string, error) {
 	appId := os.Getenv("BLIKK_APP_ID")
 	appSecret := os.Getenv("BLIKK_APP_SECRET")
 
-	encoded := b64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", appId, appSecret)))
-
-	req, err := http.NewRequest("POST", "https://publicapi.blikk.com/v1/Auth/Token", nil)
+	token, err := requestAccessToken(context.Background(), http.DefaultClient, defaultBaseURL, appId, appSecret)
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("failed to get access token: %w", err)
 	}
 
-	req.Header.Set("Authorization", "Basic "+encoded)
+	return token.AccessToken, nil
+}
 
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return "", err
+// List retrieves a collection of resources.
+// It handles pagination automatically, fetching all pages of results.
+// Cancelling ctx aborts the in-flight request as well as any rate limit
+// back-off, and the returned error identifies the page being fetched.
+//
+// Each resource has its own filter type, e.g. TimeReportFilter for
+// TimeReports; the zero value lists everything. The filter is validated
+// before any request is made and a *FilterError is returned if it is invalid.
+//
+// If the client was created WithConcurrency, the remaining pages are fetched
+// in parallel once the first page has reported the total page count. Items
+// are still returned in page order, and the first error cancels the requests
+// still in flight.
+func List[T ListItem[F], F Filter](ctx context.Context, c *Client, filter F) ([]T, error) {
+	if c.concurrency > 1 {
+		return listConcurrently[T](ctx, c, filter)
 	}
-	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return "", fmt.Errorf("failed to get access token, status %d: %s", res.StatusCode, string(bodyBytes))
+	var items []T
+
+	for page, err := range Pages[T](ctx, c, filter) {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
 	}
 
-	body, err := io.ReadAll(res.Body)
+	return items, nil
+}
+
+// listConcurrently implements List for clients with a concurrency above 1.
+func listConcurrently[T ListItem[F], F Filter](ctx context.Context, c *Client, filter F) ([]T, error) {
+	var itemType T
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	u, err := listURL(c, itemType.path(filter), filter)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	var accessTokenResponse accessTokenResponse
-	err = json.Unmarshal(body, &accessTokenResponse)
+	first, err := fetchPage[T](ctx, c, u, max(filter.pagination().Page, 1))
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	if first.Page >= first.TotalPages {
+		return first.Items, nil
+	}
+
+	remaining := make([][]T, first.TotalPages-first.Page)
+	err = runConcurrently(ctx, c.concurrency, len(remaining), func(ctx context.Context, i int) error {
+		response, err := fetchPage[T](ctx, c, u, first.Page+1+i)
+		if err != nil {
+			return err
+		}
+		remaining[i] = response.Items
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return accessTokenResponse.AccessToken, nil
+	items := first.Items
+	for _, pageItems := range remaining {
+		items = append(items, pageItems...)
+	}
+	return items, nil
 }
 
-// List retrieves a collection of resources.
-// It handles pagination automatically, fetching all pages of results.
-func List[T ListItem](c *Client, options ListOptions) ([]T, error) {
-	var items []T
-	var itemType T
+// All returns an iterator over the items of a collection of resources.
+// Pages are fetched lazily as the iteration advances, so items can be
+// processed without holding the whole collection in memory, and breaking out
+// of the loop stops fetching. An error ends the iteration after being yielded.
+//
+//	for report, err := range blikk.All[blikk.TimeReports](ctx, client, filter) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+func All[T ListItem[F], F Filter](ctx context.Context, c *Client, filter F) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page, err := range Pages[T](ctx, c, filter) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
 
-	if !itemType.validFilter(&options) {
-		return nil, fmt.Errorf("invalid filter options for %T", itemType)
+// Pages returns an iterator over the pages of a collection of resources,
+// starting at the page set in the filter's Pagination. Each page carries the
+// pagination metadata reported by the API, such as TotalItemCount and
+// TotalPages. Pages are fetched lazily as the iteration advances and an error
+// ends the iteration after being yielded.
+func Pages[T ListItem[F], F Filter](ctx context.Context, c *Client, filter F) iter.Seq2[ListResponse[T], error] {
+	return func(yield func(ListResponse[T], error) bool) {
+		var itemType T
+
+		if err := filter.Validate(); err != nil {
+			yield(ListResponse[T]{}, err)
+			return
+		}
+		u, err := listURL(c, itemType.path(filter), filter)
+		if err != nil {
+			yield(ListResponse[T]{}, err)
+			return
+		}
+
+		page := max(filter.pagination().Page, 1)
+		for {
+			response, err := fetchPage[T](ctx, c, u, page)
+			if err != nil {
+				yield(ListResponse[T]{}, err)
+				return
+			}
+			if !yield(response, nil) {
+				return
+			}
+
+			// Blikk API is 1-indexed for pages
+			if response.Page >= response.TotalPages {
+				return
+			}
+			page = response.Page + 1
+		}
 	}
-	u, err := url.Parse(c.baseURL + itemType.path())
+}
+
+// listURL builds the URL of a collection of resources, with query parameters
+// built from the filter struct. The page size defaults to defaultPageSize.
+func listURL(c *Client, path string, filter Filter) (*url.URL, error) {
+	u, err := url.Parse(c.baseURL + path)
 	if err != nil {
 		return nil, fmt.Errorf("invalid base URL: %w", err)
 	}
 	q := u.Query()
-	// Build query parameters from the options struct using reflection.
-	// It adds fields with a "paramName" tag to the query, skipping zero values.
-	// Special handling is provided for DateOnly fields and slices/arrays.
-	for i := 0; i < reflect.TypeOf(options).NumField(); i++ {
-		field := reflect.TypeOf(options).Field(i)
-		paramName := field.Tag.Get("paramName")
-		if paramName == "" {
+	addQueryParams(q, reflect.ValueOf(filter))
+	if filter.pagination().PageSize == 0 {
+		q.Set("pageSize", fmt.Sprintf("%d", defaultPageSize))
+	}
+
+	u.RawQuery = q.Encode()
+	return u, nil
+}
+
+// addQueryParams adds the fields of the struct v to q using reflection.
+// It adds fields with a "paramName" tag to the query, skipping zero values,
+// and recurses into embedded structs. Pointers are dereferenced, DateOnly
+// values are formatted as dates, time.Time values as RFC 3339 timestamps and
+// slices/arrays are joined with commas.
+func addQueryParams(q url.Values, v reflect.Value) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		fieldValue := v.Field(i)
+
+		if field.Anonymous && fieldValue.Kind() == reflect.Struct {
+			addQueryParams(q, fieldValue)
 			continue
 		}
 
-		fieldValue := reflect.ValueOf(options).Field(i)
-		if fieldValue.IsZero() {
+		paramName := field.Tag.Get("paramName")
+		if paramName == "" || fieldValue.IsZero() {
 			continue
 		}
 
-		if fieldValue.Type() == reflect.TypeOf(&DateOnly{}) {
-			date := fieldValue.Interface().(*DateOnly)
+		if fieldValue.Kind() == reflect.Pointer {
+			fieldValue = fieldValue.Elem()
+		}
+
+		if date, ok := fieldValue.Interface().(DateOnly); ok {
 			q.Set(paramName, date.Format(time.DateOnly))
 			continue
 		}
 
-		if field.Type.Kind() == reflect.Slice || field.Type.Kind() == reflect.Array {
+		if t, ok := fieldValue.Interface().(time.Time); ok {
+			q.Set(paramName, t.Format(time.RFC3339))
+			continue
+		}
+
+		if fieldValue.Kind() == reflect.Slice || fieldValue.Kind() == reflect.Array {
 			var values []string
 			for j := 0; j < fieldValue.Len(); j++ {
 				values = append(values, fmt.Sprintf("%v", fieldValue.Index(j).Interface()))
@@ -151,38 +331,32 @@ func List[T ListItem](c *Client, options ListOptions) ([]T, error) {
 		}
 		q.Set(paramName, fmt.Sprintf("%v", fieldValue.Interface()))
 	}
+}
 
-	u.RawQuery = q.Encode()
-
-	for {
-		body, err := c.doGetRequest(u)
-		if err != nil {
-			return nil, err
-		}
-
-		var response ListResponse[T]
-		err = json.Unmarshal(body, &response)
-		if err != nil {
-			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-		}
+// fetchPage retrieves a single page of a collection of resources.
+func fetchPage[T any](ctx context.Context, c *Client, u *url.URL, page int) (ListResponse[T], error) {
+	var response ListResponse[T]
 
-		items = append(items, response.Items...)
+	pageURL := *u
+	q := pageURL.Query()
+	q.Set("page", fmt.Sprintf("%d", page))
+	pageURL.RawQuery = q.Encode()
 
-		// Blikk API is 1-indexed for pages
-		if response.Page >= response.TotalPages {
-			break
-		}
+	body, err := c.doGetRequest(ctx, &pageURL)
+	if err != nil {
+		return response, fmt.Errorf("failed to fetch page %d: %w", page, err)
+	}
 
-		options.Page = response.Page + 1
-		q.Set("page", fmt.Sprintf("%d", options.Page))
-		u.RawQuery = q.Encode()
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return response, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
-	return items, nil
+	return response, nil
 }
 
 // Get retrieves a single resource by its identifier.
-func Get[T GetItem](c *Client, query string) (T, error) {
+func Get[T GetItem](ctx context.Context, c *Client, query string) (T, error) {
 	var item T
 
 	u, err := url.Parse(c.baseURL + item.path(query))
@@ -190,7 +364,7 @@ func Get[T GetItem](c *Client, query string) (T, error) {
 		return item, fmt.Errorf("invalid base URL: %w", err)
 	}
 
-	body, err := c.doGetRequest(u)
+	body, err := c.doGetRequest(ctx, u)
 	if err != nil {
 		return item, err
 	}
@@ -203,22 +377,87 @@ func Get[T GetItem](c *Client, query string) (T, error) {
 	return item, nil
 }
 
-func (c *Client) doGetRequest(u *url.URL) ([]byte, error) {
-	req, err := http.NewRequest("GET", u.String(), nil)
+// Create creates a resource from body and returns the server's
+// representation of the created resource. Validation failures are reported
+// as an *APIError with per-field ValidationErrors.
+func Create[T CreateItem[B], B any](ctx context.Context, c *Client, body B) (T, error) {
+	var item T
+	return write[T](ctx, c, http.MethodPost, item.createPath(body), body)
+}
+
+// Update replaces the resource identified by id with body and returns the
+// server's representation of the updated resource.
+func Update[T UpdateItem[B], B any](ctx context.Context, c *Client, id string, body B) (T, error) {
+	var item T
+	return write[T](ctx, c, http.MethodPut, item.updatePath(id, body), body)
+}
+
+// Patch partially updates the resource identified by id. Only the fields set
+// in body are changed. It returns the server's representation of the updated
+// resource.
+func Patch[T PatchItem[B], B any](ctx context.Context, c *Client, id string, body B) (T, error) {
+	var item T
+	return write[T](ctx, c, http.MethodPatch, item.patchPath(id, body), body)
+}
+
+// Delete deletes the resource identified by id.
+func Delete[T DeleteItem](ctx context.Context, c *Client, id string) error {
+	var item T
+
+	u, err := url.Parse(c.baseURL + item.deletePath(id))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return fmt.Errorf("invalid base URL: %w", err)
 	}
-	req.Header.Set("Authorization", "Bearer "+c.token)
 
-	resp, err := c.retryRequest(req)
+	_, err = c.doRequest(ctx, http.MethodDelete, u, nil)
+	return err
+}
+
+// write sends body as JSON to path and decodes the response into T.
+func write[T any](ctx context.Context, c *Client, method, path string, body any) (T, error) {
+	var item T
+
+	u, err := url.Parse(c.baseURL + path)
+	if err != nil {
+		return item, fmt.Errorf("invalid base URL: %w", err)
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return item, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	respBody, err := c.doRequest(ctx, method, u, payload)
+	if err != nil {
+		return item, err
+	}
+	if len(bytes.TrimSpace(respBody)) == 0 {
+		return item, nil
+	}
+
+	err = json.Unmarshal(respBody, &item)
+	if err != nil {
+		return item, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return item, nil
+}
+
+func (c *Client) doGetRequest(ctx context.Context, u *url.URL) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodGet, u, nil)
+}
+
+// doRequest issues a request with an optional JSON payload and returns the
+// response body. Any status code other than 2xx is returned as an *APIError.
+func (c *Client) doRequest(ctx context.Context, method string, u *url.URL, payload []byte) ([]byte, error) {
+	resp, err := c.send(ctx, method, u, payload, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(bodyBytes))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, newAPIError(resp)
 	}
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -227,30 +466,45 @@ func (c *Client) doGetRequest(u *url.URL) ([]byte, error) {
 	return body, nil
 }
 
-func (c *Client) retryRequest(req *http.Request) (*http.Response, error) {
-	for {
-		resp, err := c.httpClient.Do(req)
+// send issues an authorized request. If the API rejects the token with
+// 401 Unauthorized and the token source is able to refresh, the request is
+// retried once with a fresh token. Values in header replace the default JSON
+// Accept and Content-Type headers.
+func (c *Client) send(ctx context.Context, method string, u *url.URL, payload []byte, header http.Header) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		token, err := c.tokenSource.Token(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get access token: %w", err)
+		}
+
+		var body io.Reader
+		if payload != nil {
+			body = bytes.NewReader(payload)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+		req.Header.Set("Accept", "application/json")
+		if payload != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		for key, values := range header {
+			req.Header[http.CanonicalHeaderKey(key)] = values
+		}
+
+		resp, err := c.retryRequest(req)
 		if err != nil {
 			return nil, err
 		}
-		if resp.StatusCode == http.StatusTooManyRequests {
-			resp.Body.Close()
-			retryAfter := resp.Header.Get("Retry-After")
-			waitDuration := time.Second // Default wait time
-
-			if retryAfter != "" {
-				if seconds, err := strconv.Atoi(retryAfter); err == nil {
-					waitDuration = time.Duration(seconds) * time.Second
-				} else if d, err := http.ParseTime(retryAfter); err == nil {
-					waitDuration = time.Until(d)
-				}
-			}
 
-			if waitDuration < 0 {
-				waitDuration = time.Second
+		if resp.StatusCode == http.StatusUnauthorized && attempt == 1 {
+			if invalidator, ok := c.tokenSource.(tokenInvalidator); ok {
+				resp.Body.Close()
+				invalidator.invalidate(token)
+				continue
 			}
-			time.Sleep(waitDuration)
-			continue
 		}
 		return resp, nil
 	}
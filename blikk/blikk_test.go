@@ -1,9 +1,12 @@
 package blikk
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -37,7 +40,7 @@ func TestList_Success(t *testing.T) {
 	client, server := setupTestServer(t, handler)
 	defer server.Close()
 
-	users, err := List[Users](client, NewListOptions())
+	users, err := List[Users](context.Background(), client, UserFilter{})
 	require.NoError(t, err)
 	require.Len(t, users, 1)
 	assert.Equal(t, 1, users[0].ID)
@@ -66,8 +69,8 @@ func TestList_Pagination(t *testing.T) {
 	client, server := setupTestServer(t, handler)
 	defer server.Close()
 
-	opts := NewListOptions()
-	items, err := List[Users](client, opts)
+	opts := UserFilter{}
+	items, err := List[Users](context.Background(), client, opts)
 	require.NoError(t, err)
 	require.Len(t, items, 2)
 	assert.Equal(t, 1, items[0].ID)
@@ -84,12 +87,41 @@ func TestGet_Success(t *testing.T) {
 	client, server := setupTestServer(t, handler)
 	defer server.Close()
 
-	user, err := Get[User](client, "123")
+	user, err := Get[User](context.Background(), client, "123")
 	require.NoError(t, err)
 	assert.Equal(t, 123, user.ID)
 	assert.Equal(t, "Specific", user.FirstName)
 }
 
+func TestGet_ProjectEconomy(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/Projects/42", r.URL.Path)
+		fmt.Fprintln(w, `{
+			"id": 42, "title": "Renovation", "description": "Kitchen and bathroom",
+			"contacts": [{"id": 9, "firstName": "Anna", "lastName": "Andersson", "role": "Site contact"}],
+			"budget": {"hours": 120, "totalCost": 60000, "revenue": 90000},
+			"contractSum": 85000, "changeOrdersSum": 5000, "totalContractSum": 90000,
+			"economy": {"reportedHours": 80.5, "timeCost": 32000, "materialCost": 8000, "totalCost": 40000, "invoicedAmount": 50000, "notInvoicedAmount": 12000},
+			"customFields": [{"id": 1, "name": "Permit", "value": "BN-2024-17"}]
+		}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	project, err := Get[Project](context.Background(), client, "42")
+	require.NoError(t, err)
+	assert.Equal(t, "Kitchen and bathroom", project.Description)
+	require.Len(t, project.Contacts, 1)
+	assert.Equal(t, "Site contact", project.Contacts[0].Role)
+	assert.Equal(t, 120.0, project.Budget.Hours)
+	assert.Equal(t, 90000.0, project.TotalContractSum)
+	assert.Equal(t, 80.5, project.Economy.ReportedHours)
+	assert.Equal(t, 12000.0, project.Economy.NotInvoicedAmount)
+	require.Len(t, project.CustomFields, 1)
+	assert.Equal(t, "BN-2024-17", project.CustomFields[0].Value)
+}
+
 func TestClient_RetryRequest(t *testing.T) {
 	attempts := 0
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -110,7 +142,7 @@ func TestClient_RetryRequest(t *testing.T) {
 
 	client := NewClient("fake-token", WithBaseURL(server.URL+"/"), WithHTTPClient(httpClient))
 
-	_, err := Get[User](client, "1")
+	_, err := Get[User](context.Background(), client, "1")
 	require.NoError(t, err)
 	assert.Equal(t, 2, attempts, "Expected the client to make two attempts")
 }
@@ -124,7 +156,7 @@ func TestClient_ServerError(t *testing.T) {
 	client, server := setupTestServer(t, handler)
 	defer server.Close()
 
-	_, err := List[Users](client, NewListOptions())
+	_, err := List[Users](context.Background(), client, UserFilter{})
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "unexpected status code 500")
 	assert.Contains(t, err.Error(), "internal server error")
@@ -139,7 +171,317 @@ func TestClient_BadJSON(t *testing.T) {
 	client, server := setupTestServer(t, handler)
 	defer server.Close()
 
-	_, err := Get[User](client, "1")
+	_, err := Get[User](context.Background(), client, "1")
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to unmarshal response")
 }
+
+func TestList_ContextCancelledDuringRetryAfter(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := List[Users](ctx, client, UserFilter{})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), "page 1")
+	assert.Less(t, time.Since(start), 5*time.Second, "Expected the back-off to be interrupted")
+}
+
+// pagedHandler serves totalPages pages of users with one item each and counts the requests made.
+func pagedHandler(t *testing.T, totalPages int, requests *int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		var page int
+		_, err := fmt.Sscanf(r.URL.Query().Get("page"), "%d", &page)
+		require.NoError(t, err)
+		fmt.Fprintf(w, `{"page": %d, "totalPages": %d, "totalItemCount": %d, "items": [{"id": %d}]}`,
+			page, totalPages, totalPages, page)
+	})
+}
+
+func TestAll_StreamsItemsAcrossPages(t *testing.T) {
+	requests := 0
+	client, server := setupTestServer(t, pagedHandler(t, 3, &requests))
+	defer server.Close()
+
+	var ids []int
+	for user, err := range All[Users](context.Background(), client, UserFilter{}) {
+		require.NoError(t, err)
+		ids = append(ids, user.ID)
+	}
+	assert.Equal(t, []int{1, 2, 3}, ids)
+	assert.Equal(t, 3, requests)
+}
+
+func TestAll_StopsFetchingOnBreak(t *testing.T) {
+	requests := 0
+	client, server := setupTestServer(t, pagedHandler(t, 3, &requests))
+	defer server.Close()
+
+	for user, err := range All[Users](context.Background(), client, UserFilter{}) {
+		require.NoError(t, err)
+		assert.Equal(t, 1, user.ID)
+		break
+	}
+	assert.Equal(t, 1, requests, "Expected no further pages to be fetched after break")
+}
+
+func TestPages_ExposesMetadata(t *testing.T) {
+	requests := 0
+	client, server := setupTestServer(t, pagedHandler(t, 2, &requests))
+	defer server.Close()
+
+	var pages []int
+	for page, err := range Pages[Users](context.Background(), client, UserFilter{}) {
+		require.NoError(t, err)
+		assert.Equal(t, 2, page.TotalItemCount)
+		assert.Equal(t, 2, page.TotalPages)
+		pages = append(pages, page.Page)
+	}
+	assert.Equal(t, []int{1, 2}, pages)
+}
+
+func TestAll_YieldsError(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	var errs []error
+	for _, err := range All[Users](context.Background(), client, UserFilter{}) {
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrNotFound)
+}
+
+func TestCreate_TimeReport(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/Core/TimeReports", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"userId": 7, "date": "2024-01-02", "hours": 7.5, "projectId": 42, "comment": "Fixed date"}`, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintln(w, `{"id": 100, "date": "2024-01-02", "hours": 7.5}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	report, err := Create[TimeReports](context.Background(), client, TimeReportRequest{
+		UserID:    7,
+		Date:      DateOnly{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		Hours:     7.5,
+		ProjectID: 42,
+		Comment:   "Fixed date",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 100, report.ID)
+	assert.Equal(t, 7.5, report.Hours)
+}
+
+func TestUpdate_Project(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/v1/Core/Projects/5", r.URL.Path)
+		fmt.Fprintln(w, `{"id": 5, "title": "Renamed"}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	project, err := Update[Projects](context.Background(), client, "5", ProjectRequest{Title: "Renamed"})
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", project.Title)
+}
+
+func TestPatch_DeactivateUser(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/v1/Admin/Users/12", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"active": false, "endDate": "2024-06-30"}`, string(body))
+
+		fmt.Fprintln(w, `{"id": 12, "endDate": "2024-06-30"}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	active := false
+	endDate := LastDayOfMonth(2024, time.June)
+	user, err := Patch[User](context.Background(), client, "12", UserPatchRequest{Active: &active, EndDate: &endDate})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-06-30", user.EndDate.Format(time.DateOnly))
+}
+
+func TestDelete_TimeReport(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/v1/Core/TimeReports/100", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	err := Delete[TimeReports](context.Background(), client, "100")
+	require.NoError(t, err)
+}
+
+func TestCreate_ValidationErrors(t *testing.T) {
+	attempts := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, `{"title": "Validation failed", "errors": {"hours": ["Hours must be greater than 0"]}}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	_, err := Create[TimeReports](context.Background(), client, TimeReportRequest{UserID: 7})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrBadRequest)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, []string{"Hours must be greater than 0"}, apiErr.ValidationErrors["hours"])
+	assert.Equal(t, 1, attempts)
+}
+
+func TestCreate_NotRetriedOnGatewayError(t *testing.T) {
+	attempts := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	_, err := Create[TimeReports](context.Background(), client, TimeReportRequest{UserID: 7, Hours: 1})
+	require.Error(t, err)
+	assert.Equal(t, 1, attempts, "Expected a non-idempotent request not to be retried after a gateway error")
+}
+
+func TestList_TimeReportFilterQuery(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "1", q.Get("page"))
+		assert.Equal(t, "50", q.Get("pageSize"))
+		assert.Equal(t, "1,2", q.Get("filter.userIds"))
+		assert.Equal(t, "10", q.Get("filter.projectIds"))
+		assert.Equal(t, "2024-03-01", q.Get("filter.from"))
+		assert.Equal(t, "2024-03-31", q.Get("filter.to"))
+		assert.Equal(t, "false", q.Get("filter.isAttested"))
+		assert.False(t, q.Has("filter.isInvoiced"))
+		assert.False(t, q.Has("filter.activityIds"))
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": []}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	from, to := FirstDayOfMonth(2024, time.March), LastDayOfMonth(2024, time.March)
+	attested := false
+	_, err := List[TimeReports](context.Background(), client, TimeReportFilter{
+		Pagination: Pagination{PageSize: 50},
+		UserIDs:    []int{1, 2},
+		ProjectIDs: []int{10},
+		FromDate:   &from,
+		ToDate:     &to,
+		Attested:   &attested,
+	})
+	require.NoError(t, err)
+}
+
+func TestList_DefaultPageSize(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "100", r.URL.Query().Get("pageSize"))
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": []}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	_, err := List[Projects](context.Background(), client, ProjectFilter{})
+	require.NoError(t, err)
+}
+
+func TestList_ConcurrentPagesPreserveOrder(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+
+		var page int
+		_, err := fmt.Sscanf(r.URL.Query().Get("page"), "%d", &page)
+		require.NoError(t, err)
+		// Later pages answer faster, so completion order differs from page order.
+		time.Sleep(time.Duration(10-page) * 5 * time.Millisecond)
+		fmt.Fprintf(w, `{"page": %d, "totalPages": 8, "items": [{"id": %d}]}`, page, page)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	client := NewClient("fake-token", WithBaseURL(server.URL+"/"), WithHTTPClient(server.Client()), WithConcurrency(3))
+
+	users, err := List[Users](context.Background(), client, UserFilter{})
+	require.NoError(t, err)
+
+	var ids []int
+	for _, user := range users {
+		ids = append(ids, user.ID)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, ids)
+	assert.Greater(t, maxInFlight.Load(), int32(1))
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(3))
+}
+
+func TestList_ConcurrentPagesStopOnError(t *testing.T) {
+	var requests atomic.Int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		page := r.URL.Query().Get("page")
+		if page == "2" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+		fmt.Fprintf(w, `{"page": %s, "totalPages": 50, "items": [{"id": 1}]}`, page)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	client := NewClient("fake-token", WithBaseURL(server.URL+"/"), WithHTTPClient(server.Client()), WithConcurrency(2))
+
+	_, err := List[Users](context.Background(), client, UserFilter{})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Contains(t, err.Error(), "page 2")
+	assert.Less(t, requests.Load(), int32(50), "Expected the remaining pages to be cancelled")
+}
@@ -0,0 +1,108 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// InternalProjects is an internal project as returned when listing internal
+// projects. Internal projects track overhead such as training,
+// administration and sales.
+type InternalProjects struct {
+	ObjectName  string      `json:"objectName"`
+	ID          int         `json:"id"`
+	Name        string      `json:"name"`
+	Code        string      `json:"code"`
+	IsActive    bool        `json:"isActive"`
+	Activity    blikkObject `json:"activity"`
+	CostCenter  blikkObject `json:"costCenter"`
+	TimeCode    blikkObject `json:"timeCode"`
+	SalaryCode  string      `json:"salaryCode"`
+	CreatedDate string      `json:"createdDate"`
+	UpdatedDate string      `json:"updatedDate"`
+}
+
+func (InternalProjects) path(ReferenceDataFilter) string {
+	return "v1/Core/InternalProjects"
+}
+
+type InternalProject InternalProjects
+
+func (InternalProject) path(query string) string {
+	return "v1/Core/InternalProjects/" + query
+}
+
+// ProjectCollections is a project collection as returned when listing
+// project collections. Collections group customer projects, for example
+// under a framework agreement.
+type ProjectCollections struct {
+	ObjectName     string      `json:"objectName"`
+	ID             int         `json:"id"`
+	Number         string      `json:"number"`
+	Name           string      `json:"name"`
+	Customer       blikkObject `json:"customer"`
+	ProjectManager blikkObject `json:"projectManager"`
+	IsActive       bool        `json:"isActive"`
+	CreatedDate    string      `json:"createdDate"`
+	UpdatedDate    string      `json:"updatedDate"`
+}
+
+func (ProjectCollections) path(ProjectCollectionFilter) string {
+	return "v1/Core/ProjectCollections"
+}
+
+// ProjectCollectionFilter filters the collections returned when listing
+// ProjectCollections.
+type ProjectCollectionFilter struct {
+	Pagination
+	// Query matches collections by free text, such as name or number.
+	Query       string `paramName:"filter.query"`
+	CustomerIDs []int  `paramName:"filter.customerIds"`
+	IsActive    *bool  `paramName:"filter.isActive"`
+}
+
+func (f ProjectCollectionFilter) Validate() error {
+	return newFilterError(f, f.Pagination.violations())
+}
+
+// ProjectCollection is the full detail of a single project collection.
+// Get[ProjectCollection] leaves Projects empty; GetProjectCollection also
+// resolves the member projects.
+type ProjectCollection struct {
+	ObjectName     string      `json:"objectName"`
+	ID             int         `json:"id"`
+	Number         string      `json:"number"`
+	Name           string      `json:"name"`
+	Description    string      `json:"description"`
+	Customer       blikkObject `json:"customer"`
+	ProjectManager blikkObject `json:"projectManager"`
+	IsActive       bool        `json:"isActive"`
+	CreatedBy      blikkObject `json:"createdBy"`
+	UpdatedBy      blikkObject `json:"updatedBy"`
+	CreatedDate    string      `json:"createdDate"`
+	UpdatedDate    string      `json:"updatedDate"`
+	// Projects is the member projects of the collection, set by
+	// GetProjectCollection.
+	Projects []Projects `json:"-"`
+}
+
+func (ProjectCollection) path(query string) string {
+	return "v1/Core/ProjectCollections/" + query
+}
+
+// GetProjectCollection retrieves the project collection identified by id
+// together with all of its member projects.
+func GetProjectCollection(ctx context.Context, c *Client, id int) (ProjectCollection, error) {
+	collection, err := Get[ProjectCollection](ctx, c, strconv.Itoa(id))
+	if err != nil {
+		return collection, err
+	}
+
+	projects, err := List[Projects](ctx, c, ProjectFilter{ProjectCollectionIDs: []int{id}})
+	if err != nil {
+		return collection, fmt.Errorf("failed to list projects in collection %d: %w", id, err)
+	}
+	collection.Projects = projects
+	return collection, nil
+}
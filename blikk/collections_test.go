@@ -0,0 +1,68 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList_InternalProjects(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/InternalProjects", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("filter.isActive"))
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": [{"id": 2, "name": "Utbildning", "code": "INT-2", "isActive": true}]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	isActive := true
+	projects, err := List[InternalProjects](context.Background(), client, ReferenceDataFilter{IsActive: &isActive})
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	assert.Equal(t, "Utbildning", projects[0].Name)
+}
+
+func TestGetProjectCollection_ResolvesProjects(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/Core/ProjectCollections/6":
+			fmt.Fprintln(w, `{"id": 6, "number": "RA-6", "name": "Ramavtal Kommunen", "customer": {"id": 3}}`)
+		case "/v1/Core/Projects":
+			assert.Equal(t, "6", r.URL.Query().Get("filter.projectCollectionIds"))
+			fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": [
+				{"id": 42, "title": "Skola", "projectCollection": {"id": 6, "number": "RA-6"}},
+				{"id": 43, "title": "Förskola", "projectCollection": {"id": 6, "number": "RA-6"}}
+			]}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	collection, err := GetProjectCollection(context.Background(), client, 6)
+	require.NoError(t, err)
+	assert.Equal(t, "RA-6", collection.Number)
+	require.Len(t, collection.Projects, 2)
+	assert.Equal(t, 43, collection.Projects[1].ID)
+}
+
+func TestGetProjectCollection_NotFound(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/ProjectCollections/9", r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	_, err := GetProjectCollection(context.Background(), client, 9)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,154 @@
+package blikk
+
+import "time"
+
+// ContactType distinguishes companies from private persons.
+type ContactType string
+
+const (
+	ContactTypeCompany       ContactType = "Company"
+	ContactTypePrivatePerson ContactType = "PrivatePerson"
+)
+
+// ContactPerson is a person at a company contact.
+type ContactPerson struct {
+	ObjectName        string `json:"objectName"`
+	ID                int    `json:"id"`
+	FirstName         string `json:"firstName"`
+	LastName          string `json:"lastName"`
+	Title             string `json:"title"`
+	Email             string `json:"email"`
+	PhoneNumber       string `json:"phoneNumber"`
+	MobilePhoneNumber string `json:"mobilePhoneNumber"`
+	IsMainContact     bool   `json:"isMainContact"`
+}
+
+// Contacts is a customer or supplier as returned when listing contacts. Use
+// Get[Contact] for the full details.
+type Contacts struct {
+	ObjectName         string      `json:"objectName"`
+	ID                 int         `json:"id"`
+	Type               ContactType `json:"type"`
+	Name               string      `json:"name"`
+	OrganizationNumber string      `json:"organizationNumber"`
+	CustomerNumber     string      `json:"customerNumber"`
+	SupplierNumber     string      `json:"supplierNumber"`
+	IsCustomer         bool        `json:"isCustomer"`
+	IsSupplier         bool        `json:"isSupplier"`
+	IsActive           bool        `json:"isActive"`
+	Email              string      `json:"email"`
+	PhoneNumber        string      `json:"phoneNumber"`
+	Address            Address     `json:"address"`
+	CreatedDate        string      `json:"createdDate"`
+	UpdatedDate        string      `json:"updatedDate"`
+}
+
+func (Contacts) path(ContactFilter) string {
+	return "v1/Core/Contacts"
+}
+
+// ContactFilter filters the contacts returned when listing Contacts.
+type ContactFilter struct {
+	Pagination
+	Type ContactType `paramName:"filter.type"`
+	// Query matches contacts by free text, such as name, organisation
+	// number or customer number.
+	Query        string     `paramName:"filter.query"`
+	IsCustomer   *bool      `paramName:"filter.isCustomer"`
+	IsSupplier   *bool      `paramName:"filter.isSupplier"`
+	UpdatedSince *time.Time `paramName:"filter.updatedSince"`
+}
+
+func (f ContactFilter) Validate() error {
+	violations := f.Pagination.violations()
+	violations = append(violations, unsupportedValueViolations("Type", f.Type, ContactTypeCompany, ContactTypePrivatePerson)...)
+	return newFilterError(f, violations)
+}
+
+type Contact struct {
+	ObjectName         string          `json:"objectName"`
+	ID                 int             `json:"id"`
+	Type               ContactType     `json:"type"`
+	Name               string          `json:"name"`
+	FirstName          string          `json:"firstName"`
+	LastName           string          `json:"lastName"`
+	OrganizationNumber string          `json:"organizationNumber"`
+	VATNumber          string          `json:"vatNumber"`
+	CustomerNumber     string          `json:"customerNumber"`
+	SupplierNumber     string          `json:"supplierNumber"`
+	IsCustomer         bool            `json:"isCustomer"`
+	IsSupplier         bool            `json:"isSupplier"`
+	IsActive           bool            `json:"isActive"`
+	Email              string          `json:"email"`
+	InvoiceEmail       string          `json:"invoiceEmail"`
+	PhoneNumber        string          `json:"phoneNumber"`
+	Website            string          `json:"website"`
+	Note               string          `json:"note"`
+	Address            Address         `json:"address"`
+	VisitingAddress    Address         `json:"visitingAddress"`
+	DeliveryAddress    Address         `json:"deliveryAddress"`
+	ContactPersons     []ContactPerson `json:"contactPersons"`
+	PaymentTerms       struct {
+		blikkObject
+		Days int `json:"days"`
+	} `json:"paymentTerms"`
+	PriceList   blikkObject `json:"priceList"`
+	OurContact  blikkObject `json:"ourContact"`
+	CreatedBy   blikkObject `json:"createdBy"`
+	UpdatedBy   blikkObject `json:"updatedBy"`
+	CreatedDate string      `json:"createdDate"`
+	UpdatedDate string      `json:"updatedDate"`
+}
+
+func (Contact) path(query string) string {
+	return "v1/Core/Contacts/" + query
+}
+
+func (Contact) createPath(ContactRequest) string {
+	return "v1/Core/Contacts"
+}
+
+func (Contact) updatePath(id string, _ ContactRequest) string {
+	return "v1/Core/Contacts/" + id
+}
+
+// ContactRequest is the request body for creating or updating a contact.
+// Companies are identified by Name and OrganizationNumber, private persons
+// by FirstName and LastName.
+type ContactRequest struct {
+	Type               ContactType            `json:"type"`
+	Name               string                 `json:"name,omitempty"`
+	FirstName          string                 `json:"firstName,omitempty"`
+	LastName           string                 `json:"lastName,omitempty"`
+	OrganizationNumber string                 `json:"organizationNumber,omitempty"`
+	VATNumber          string                 `json:"vatNumber,omitempty"`
+	CustomerNumber     string                 `json:"customerNumber,omitempty"`
+	SupplierNumber     string                 `json:"supplierNumber,omitempty"`
+	IsCustomer         bool                   `json:"isCustomer"`
+	IsSupplier         bool                   `json:"isSupplier"`
+	Email              string                 `json:"email,omitempty"`
+	InvoiceEmail       string                 `json:"invoiceEmail,omitempty"`
+	PhoneNumber        string                 `json:"phoneNumber,omitempty"`
+	Website            string                 `json:"website,omitempty"`
+	Note               string                 `json:"note,omitempty"`
+	Address            *Address               `json:"address,omitempty"`
+	VisitingAddress    *Address               `json:"visitingAddress,omitempty"`
+	DeliveryAddress    *Address               `json:"deliveryAddress,omitempty"`
+	ContactPersons     []ContactPersonRequest `json:"contactPersons,omitempty"`
+	PaymentTermsID     int                    `json:"paymentTermsId,omitempty"`
+	PriceListID        int                    `json:"priceListId,omitempty"`
+	OurContactID       int                    `json:"ourContactId,omitempty"`
+}
+
+// ContactPersonRequest is a contact person in a ContactRequest. ID is set
+// to update an existing person and left zero to add a new one.
+type ContactPersonRequest struct {
+	ID                int    `json:"id,omitempty"`
+	FirstName         string `json:"firstName"`
+	LastName          string `json:"lastName,omitempty"`
+	Title             string `json:"title,omitempty"`
+	Email             string `json:"email,omitempty"`
+	PhoneNumber       string `json:"phoneNumber,omitempty"`
+	MobilePhoneNumber string `json:"mobilePhoneNumber,omitempty"`
+	IsMainContact     bool   `json:"isMainContact,omitempty"`
+}
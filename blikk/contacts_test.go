@@ -0,0 +1,106 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList_ContactsFilter(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/Contacts", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "Company", q.Get("filter.type"))
+		assert.Equal(t, "acme", q.Get("filter.query"))
+		assert.Equal(t, "true", q.Get("filter.isCustomer"))
+		assert.Equal(t, "2024-05-01T08:00:00Z", q.Get("filter.updatedSince"))
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": [
+			{"id": 3, "type": "Company", "name": "Acme AB", "organizationNumber": "556000-0000", "isCustomer": true}
+		]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	isCustomer := true
+	since := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+	contacts, err := List[Contacts](context.Background(), client, ContactFilter{
+		Type:         ContactTypeCompany,
+		Query:        "acme",
+		IsCustomer:   &isCustomer,
+		UpdatedSince: &since,
+	})
+	require.NoError(t, err)
+	require.Len(t, contacts, 1)
+	assert.Equal(t, ContactTypeCompany, contacts[0].Type)
+	assert.Equal(t, "556000-0000", contacts[0].OrganizationNumber)
+}
+
+func TestContactFilter_ValidateType(t *testing.T) {
+	err := ContactFilter{Type: "Supplier"}.Validate()
+
+	var filterErr *FilterError
+	require.ErrorAs(t, err, &filterErr)
+	assert.Equal(t, []FilterViolation{{Field: "Type", Rule: RuleUnsupported, Allowed: "Company, PrivatePerson"}}, filterErr.Violations)
+}
+
+func TestGet_Contact(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/Contacts/3", r.URL.Path)
+		fmt.Fprintln(w, `{
+			"id": 3, "type": "Company", "name": "Acme AB", "customerNumber": "1001",
+			"address": {"streetAddress": "Storgatan 1", "postalCode": "111 22", "city": "Stockholm"},
+			"contactPersons": [{"id": 9, "firstName": "Anna", "lastName": "Andersson", "isMainContact": true}],
+			"paymentTerms": {"id": 2, "name": "30 dagar", "days": 30}
+		}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	contact, err := Get[Contact](context.Background(), client, "3")
+	require.NoError(t, err)
+	assert.Equal(t, "1001", contact.CustomerNumber)
+	assert.Equal(t, "Stockholm", contact.Address.City)
+	require.Len(t, contact.ContactPersons, 1)
+	assert.True(t, contact.ContactPersons[0].IsMainContact)
+	assert.Equal(t, 30, contact.PaymentTerms.Days)
+}
+
+func TestCreate_Contact(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/Core/Contacts", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"type": "PrivatePerson", "firstName": "Erik", "lastName": "Eriksson", "isCustomer": true, "isSupplier": false,
+			"contactPersons": [{"firstName": "Eva", "email": "eva@example.com"}]
+		}`, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintln(w, `{"id": 4, "type": "PrivatePerson", "firstName": "Erik", "lastName": "Eriksson"}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	contact, err := Create[Contact](context.Background(), client, ContactRequest{
+		Type:       ContactTypePrivatePerson,
+		FirstName:  "Erik",
+		LastName:   "Eriksson",
+		IsCustomer: true,
+		ContactPersons: []ContactPersonRequest{
+			{FirstName: "Eva", Email: "eva@example.com"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 4, contact.ID)
+}
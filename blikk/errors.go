@@ -0,0 +1,127 @@
+package blikk
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Sentinel errors matched by APIError through errors.Is, for example
+//
+//	if errors.Is(err, blikk.ErrNotFound) { ... }
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServer       = errors.New("server error")
+)
+
+// APIError is returned when the Blikk API responds with an unexpected status
+// code. Use errors.As to inspect it, or errors.Is with one of the sentinel
+// errors to check the kind of failure.
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	Header     http.Header
+	// Body is the raw response body.
+	Body []byte
+	// Message is the error message from the Blikk error payload, if any.
+	Message string
+	// ValidationErrors maps field names to the validation messages the API
+	// reported for them, if any.
+	ValidationErrors map[string][]string
+}
+
+// errorResponse is the error payload returned by the Blikk API.
+type errorResponse struct {
+	Message string              `json:"message"`
+	Title   string              `json:"title"`
+	Detail  string              `json:"detail"`
+	Errors  map[string][]string `json:"errors"`
+}
+
+// newAPIError builds an APIError from a non-successful response, consuming
+// its body. The caller remains responsible for closing the body.
+func newAPIError(resp *http.Response) *APIError {
+	body, _ := io.ReadAll(resp.Body)
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		apiErr.URL = resp.Request.URL.String()
+	}
+
+	var payload errorResponse
+	if err := json.Unmarshal(body, &payload); err == nil {
+		switch {
+		case payload.Message != "":
+			apiErr.Message = payload.Message
+		case payload.Detail != "":
+			apiErr.Message = payload.Detail
+		default:
+			apiErr.Message = payload.Title
+		}
+		if len(payload.Errors) > 0 {
+			apiErr.ValidationErrors = payload.Errors
+		}
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	var sb strings.Builder
+	if e.Method != "" {
+		fmt.Fprintf(&sb, "%s %s: ", e.Method, e.URL)
+	}
+	fmt.Fprintf(&sb, "unexpected status code %d", e.StatusCode)
+
+	switch {
+	case e.Message != "":
+		sb.WriteString(": " + e.Message)
+	case len(bytes.TrimSpace(e.Body)) > 0:
+		sb.WriteString(": " + string(bytes.TrimSpace(e.Body)))
+	}
+
+	fields := make([]string, 0, len(e.ValidationErrors))
+	for field := range e.ValidationErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		fmt.Fprintf(&sb, "; %s: %s", field, strings.Join(e.ValidationErrors[field], ", "))
+	}
+
+	return sb.String()
+}
+
+// Is reports whether target is the sentinel error matching the status code.
+func (e *APIError) Is(target error) bool {
+	switch e.StatusCode {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return target == ErrBadRequest
+	case http.StatusUnauthorized:
+		return target == ErrUnauthorized
+	case http.StatusForbidden:
+		return target == ErrForbidden
+	case http.StatusNotFound:
+		return target == ErrNotFound
+	case http.StatusConflict:
+		return target == ErrConflict
+	case http.StatusTooManyRequests:
+		return target == ErrRateLimited
+	}
+	return e.StatusCode >= 500 && target == ErrServer
+}
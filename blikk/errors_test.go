@@ -0,0 +1,83 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIError_Sentinels(t *testing.T) {
+	testCases := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusBadRequest, ErrBadRequest},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusConflict, ErrConflict},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusBadGateway, ErrServer},
+	}
+
+	for _, tc := range testCases {
+		t.Run(http.StatusText(tc.status), func(t *testing.T) {
+			err := &APIError{StatusCode: tc.status}
+			assert.ErrorIs(t, err, tc.want)
+			assert.ErrorIs(t, fmt.Errorf("wrapped: %w", err), tc.want)
+		})
+	}
+
+	assert.NotErrorIs(t, &APIError{StatusCode: http.StatusNotFound}, ErrForbidden)
+}
+
+func TestGet_NotFoundReturnsAPIError(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintln(w, `{"message": "User not found"}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	_, err := Get[User](context.Background(), client, "999")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, http.MethodGet, apiErr.Method)
+	assert.Equal(t, server.URL+"/v1/Admin/Users/999", apiErr.URL)
+	assert.Equal(t, "application/json", apiErr.Header.Get("Content-Type"))
+	assert.Equal(t, "User not found", apiErr.Message)
+	assert.Contains(t, string(apiErr.Body), "User not found")
+}
+
+func TestList_ValidationErrors(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, `{
+			"title": "One or more validation errors occurred.",
+			"errors": {"filter.to": ["Must be after filter.from"], "pageSize": ["Too large", "Must be positive"]}
+		}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	_, err := List[Users](context.Background(), client, UserFilter{})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrBadRequest)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "One or more validation errors occurred.", apiErr.Message)
+	assert.Equal(t, []string{"Must be after filter.from"}, apiErr.ValidationErrors["filter.to"])
+	assert.Contains(t, err.Error(), "pageSize: Too large, Must be positive")
+}
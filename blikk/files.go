@@ -0,0 +1,132 @@
+package blikk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"path/filepath"
+	"strconv"
+)
+
+// ProjectFiles is a document or photo attached to a project as returned when
+// listing project files. Size is in bytes.
+type ProjectFiles struct {
+	ObjectName  string      `json:"objectName"`
+	ID          int         `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Size        int64       `json:"size"`
+	MIMEType    string      `json:"mimeType"`
+	Project     blikkObject `json:"project"`
+	UploadedBy  blikkObject `json:"uploadedBy"`
+	CreatedDate string      `json:"createdDate"`
+	UpdatedDate string      `json:"updatedDate"`
+}
+
+func (ProjectFiles) path(filter ProjectFileFilter) string {
+	return "v1/Core/Projects/" + strconv.Itoa(filter.ProjectID) + "/Files"
+}
+
+// ProjectFileFilter selects the project whose files are returned when
+// listing ProjectFiles. ProjectID is required.
+type ProjectFileFilter struct {
+	Pagination
+	ProjectID int
+	// Query matches files by name.
+	Query string `paramName:"filter.query"`
+}
+
+func (f ProjectFileFilter) Validate() error {
+	violations := f.Pagination.violations()
+	if f.ProjectID <= 0 {
+		violations = append(violations, FilterViolation{Field: "ProjectID", Rule: RuleRequired, Allowed: "greater than 0"})
+	}
+	return newFilterError(f, violations)
+}
+
+// DownloadProjectFile streams the content of a project file. The content is
+// not buffered; the caller must close the returned io.ReadCloser. The HTTP
+// client's timeout does not apply, since it would also cover reading the
+// content, so use ctx to bound the download.
+func DownloadProjectFile(ctx context.Context, c *Client, projectID, fileID int) (io.ReadCloser, error) {
+	u, err := url.Parse(c.baseURL + fmt.Sprintf("v1/Core/Projects/%d/Files/%d/Content", projectID, fileID))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL: %w", err)
+	}
+
+	resp, err := c.withoutTimeout().send(ctx, http.MethodGet, u, nil, http.Header{"Accept": {"*/*"}})
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		return nil, newAPIError(resp)
+	}
+	return resp.Body, nil
+}
+
+// UploadProjectFile uploads the content read from r as a new file named name
+// on a project, and returns the created file. The file's content type is
+// derived from the extension of name, falling back to
+// application/octet-stream. The whole content is read into memory before it
+// is sent so that the upload can be retried, so very large files need as
+// much memory as their size.
+func UploadProjectFile(ctx context.Context, c *Client, projectID int, name string, r io.Reader) (ProjectFiles, error) {
+	var file ProjectFiles
+
+	u, err := url.Parse(c.baseURL + fmt.Sprintf("v1/Core/Projects/%d/Files", projectID))
+	if err != nil {
+		return file, fmt.Errorf("invalid base URL: %w", err)
+	}
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	contentType := mime.TypeByExtension(filepath.Ext(name))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	part, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Disposition": {mime.FormatMediaType("form-data", map[string]string{"name": "file", "filename": name})},
+		"Content-Type":        {contentType},
+	})
+	if err != nil {
+		return file, fmt.Errorf("failed to create multipart body: %w", err)
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return file, fmt.Errorf("failed to read file content: %w", err)
+	}
+	if err := mw.Close(); err != nil {
+		return file, fmt.Errorf("failed to create multipart body: %w", err)
+	}
+
+	resp, err := c.send(ctx, http.MethodPost, u, buf.Bytes(), http.Header{"Content-Type": {mw.FormDataContentType()}})
+	if err != nil {
+		return file, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return file, newAPIError(resp)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&file); err != nil {
+		return file, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return file, nil
+}
+
+// withoutTimeout returns a copy of c whose HTTP client has no timeout, for
+// requests whose response body is streamed to the caller.
+func (c *Client) withoutTimeout() *Client {
+	httpClient := *c.httpClient
+	httpClient.Timeout = 0
+	streaming := *c
+	streaming.httpClient = &httpClient
+	return &streaming
+}
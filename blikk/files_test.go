@@ -0,0 +1,131 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList_ProjectFiles(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/Projects/42/Files", r.URL.Path)
+		assert.Equal(t, "jpg", r.URL.Query().Get("filter.query"))
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": [
+			{"id": 5, "name": "badrum.jpg", "size": 245760, "mimeType": "image/jpeg", "uploadedBy": {"id": 7, "name": "Anna Andersson"}, "createdDate": "2024-05-06T09:12:00Z"}
+		]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	files, err := List[ProjectFiles](context.Background(), client, ProjectFileFilter{ProjectID: 42, Query: "jpg"})
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, int64(245760), files[0].Size)
+	assert.Equal(t, "image/jpeg", files[0].MIMEType)
+	assert.Equal(t, 7, files[0].UploadedBy.ID)
+}
+
+func TestList_ProjectFilesRequiresProjectID(t *testing.T) {
+	client := NewClient("fake-token")
+
+	_, err := List[ProjectFiles](context.Background(), client, ProjectFileFilter{})
+	require.ErrorIs(t, err, ErrInvalidFilter)
+
+	var filterErr *FilterError
+	require.ErrorAs(t, err, &filterErr)
+	assert.Equal(t, "ProjectID", filterErr.Violations[0].Field)
+	assert.Equal(t, RuleRequired, filterErr.Violations[0].Rule)
+}
+
+func TestDownloadProjectFile(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/Projects/42/Files/5/Content", r.URL.Path)
+		assert.Equal(t, "*/*", r.Header.Get("Accept"))
+		assert.Equal(t, "Bearer fake-token", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "image/jpeg")
+		fmt.Fprint(w, "jpeg-bytes")
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	content, err := DownloadProjectFile(context.Background(), client, 42, 5)
+	require.NoError(t, err)
+	defer content.Close()
+
+	data, err := io.ReadAll(content)
+	require.NoError(t, err)
+	assert.Equal(t, "jpeg-bytes", string(data))
+}
+
+func TestDownloadProjectFile_SlowBody(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "jpeg-")
+		w.(http.Flusher).Flush()
+		time.Sleep(200 * time.Millisecond)
+		fmt.Fprint(w, "bytes")
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	httpClient := &http.Client{Timeout: 50 * time.Millisecond, Transport: server.Client().Transport}
+	client := NewClient("fake-token", WithBaseURL(server.URL+"/"), WithHTTPClient(httpClient))
+
+	content, err := DownloadProjectFile(context.Background(), client, 42, 5)
+	require.NoError(t, err)
+	defer content.Close()
+
+	data, err := io.ReadAll(content)
+	require.NoError(t, err)
+	assert.Equal(t, "jpeg-bytes", string(data))
+	assert.Equal(t, 50*time.Millisecond, httpClient.Timeout)
+}
+
+func TestDownloadProjectFile_NotFound(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	content, err := DownloadProjectFile(context.Background(), client, 42, 99)
+	assert.Nil(t, content)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestUploadProjectFile(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/Core/Projects/42/Files", r.URL.Path)
+
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		defer file.Close()
+		data, err := io.ReadAll(file)
+		require.NoError(t, err)
+		assert.Equal(t, "ritning.pdf", header.Filename)
+		assert.Equal(t, "application/pdf", header.Header.Get("Content-Type"))
+		assert.Equal(t, "%PDF-1.7", string(data))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintln(w, `{"id": 6, "name": "ritning.pdf", "size": 8, "mimeType": "application/pdf"}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	file, err := UploadProjectFile(context.Background(), client, 42, "ritning.pdf", strings.NewReader("%PDF-1.7"))
+	require.NoError(t, err)
+	assert.Equal(t, 6, file.ID)
+	assert.Equal(t, "application/pdf", file.MIMEType)
+}
@@ -0,0 +1,147 @@
+package blikk
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maxUserDayStatisticsRange is the longest date range the API accepts when
+// listing UserDayStatistics.
+const maxUserDayStatisticsRange = 31 * 24 * time.Hour
+
+// Pagination controls the page List starts at and the number of items per
+// page. It is embedded in every filter type; the zero value starts at the
+// first page with the default page size.
+type Pagination struct {
+	Page     int `paramName:"page"`
+	PageSize int `paramName:"pageSize"`
+}
+
+func (p Pagination) pagination() Pagination {
+	return p
+}
+
+func (p Pagination) violations() []FilterViolation {
+	var violations []FilterViolation
+	if p.Page < 0 {
+		violations = append(violations, FilterViolation{Field: "Page", Rule: RuleOutOfRange, Allowed: "0 or greater"})
+	}
+	if p.PageSize < 0 {
+		violations = append(violations, FilterViolation{Field: "PageSize", Rule: RuleOutOfRange, Allowed: "0 or greater"})
+	}
+	return violations
+}
+
+// Filter is implemented by the per-resource filter types accepted by List,
+// such as TimeReportFilter and ProjectFilter. All of them embed Pagination.
+type Filter interface {
+	pagination() Pagination
+	// Validate checks the filter without making a request. It returns a
+	// *FilterError describing every violation, or nil if the filter is valid.
+	Validate() error
+}
+
+// ErrInvalidFilter is matched by every *FilterError through errors.Is,
+// including those reporting an invalid request body such as an
+// AttestRequest.
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// FilterRule identifies the rule a filter field violates.
+type FilterRule string
+
+const (
+	// RuleUnsupported means the value or combination of values is not
+	// supported by the resource.
+	RuleUnsupported FilterRule = "unsupported filter"
+	// RuleFromAfterTo means the start of a date range is after its end.
+	RuleFromAfterTo FilterRule = "from after to"
+	// RuleRangeTooLong means a date range exceeds the longest range the
+	// resource accepts.
+	RuleRangeTooLong FilterRule = "range too long"
+	// RuleOutOfRange means a numeric value is outside the accepted range.
+	RuleOutOfRange FilterRule = "out of range"
+	// RuleRequired means a required field is not set.
+	RuleRequired FilterRule = "required"
+)
+
+// FilterViolation describes a single filter field that was rejected.
+type FilterViolation struct {
+	// Field is the name of the offending filter field, e.g. "ToDate".
+	Field string
+	Rule  FilterRule
+	// Allowed describes the values the field accepts.
+	Allowed string
+}
+
+// FilterError is returned by List, All, Pages and Filter.Validate when a
+// filter is rejected before any request is made. Operations that check
+// their input up front, such as Attest with AttestRequest.Validate, report
+// it the same way.
+type FilterError struct {
+	// Filter is the name of the filter or request type, e.g.
+	// "TimeReportFilter" or "AttestRequest".
+	Filter     string
+	Violations []FilterViolation
+}
+
+func (e *FilterError) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = fmt.Sprintf("%s: %s", v.Field, v.Rule)
+		if v.Allowed != "" {
+			parts[i] += fmt.Sprintf(" (allowed: %s)", v.Allowed)
+		}
+	}
+	return fmt.Sprintf("invalid %s: %s", e.Filter, strings.Join(parts, "; "))
+}
+
+// Is reports whether target is ErrInvalidFilter.
+func (e *FilterError) Is(target error) bool {
+	return target == ErrInvalidFilter
+}
+
+// newFilterError returns a *FilterError for filter if there are any
+// violations, and nil otherwise.
+func newFilterError(filter any, violations []FilterViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	return &FilterError{
+		Filter:     strings.TrimPrefix(fmt.Sprintf("%T", filter), "blikk."),
+		Violations: violations,
+	}
+}
+
+// dateRangeViolations checks that from is not after to and, if maxRange is
+// non-zero, that the range does not exceed it.
+func dateRangeViolations(from, to *DateOnly, maxRange time.Duration) []FilterViolation {
+	if from == nil || to == nil {
+		return nil
+	}
+	if from.After(to.Time) {
+		return []FilterViolation{{Field: "FromDate", Rule: RuleFromAfterTo, Allowed: "a date on or before ToDate"}}
+	}
+	if maxRange > 0 && to.Sub(from.Time) > maxRange {
+		return []FilterViolation{{
+			Field:   "ToDate",
+			Rule:    RuleRangeTooLong,
+			Allowed: fmt.Sprintf("at most %d days after FromDate", int(maxRange/(24*time.Hour))),
+		}}
+	}
+	return nil
+}
+
+// unsupportedValueViolations checks that value, if set, is one of allowed.
+func unsupportedValueViolations[V ~string](field string, value V, allowed ...V) []FilterViolation {
+	if value == "" || slices.Contains(allowed, value) {
+		return nil
+	}
+	names := make([]string, len(allowed))
+	for i, a := range allowed {
+		names[i] = string(a)
+	}
+	return []FilterViolation{{Field: field, Rule: RuleUnsupported, Allowed: strings.Join(names, ", ")}}
+}
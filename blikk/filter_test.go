@@ -0,0 +1,76 @@
+package blikk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserDayStatisticsFilter_Validate(t *testing.T) {
+	date := func(day int) *DateOnly {
+		return &DateOnly{Time: time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC)}
+	}
+
+	testCases := []struct {
+		name   string
+		filter UserDayStatisticsFilter
+		want   []FilterViolation
+	}{
+		{"Empty", UserDayStatisticsFilter{}, nil},
+		{"31 days", UserDayStatisticsFilter{FromDate: date(1), ToDate: date(31)}, nil},
+		{
+			"From after to",
+			UserDayStatisticsFilter{FromDate: date(10), ToDate: date(1)},
+			[]FilterViolation{{Field: "FromDate", Rule: RuleFromAfterTo, Allowed: "a date on or before ToDate"}},
+		},
+		{
+			"Range too long",
+			UserDayStatisticsFilter{FromDate: date(1), ToDate: date(41)},
+			[]FilterViolation{{Field: "ToDate", Rule: RuleRangeTooLong, Allowed: "at most 31 days after FromDate"}},
+		},
+		{"Only from", UserDayStatisticsFilter{FromDate: date(1)}, nil},
+		{"Only to", UserDayStatisticsFilter{ToDate: date(31)}, nil},
+		{
+			"Negative page size",
+			UserDayStatisticsFilter{Pagination: Pagination{PageSize: -1}},
+			[]FilterViolation{{Field: "PageSize", Rule: RuleOutOfRange, Allowed: "0 or greater"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.filter.Validate()
+			if tc.want == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			var filterErr *FilterError
+			require.ErrorAs(t, err, &filterErr)
+			assert.Equal(t, "UserDayStatisticsFilter", filterErr.Filter)
+			assert.Equal(t, tc.want, filterErr.Violations)
+			assert.ErrorIs(t, err, ErrInvalidFilter)
+		})
+	}
+}
+
+func TestList_InvalidFilterMakesNoRequest(t *testing.T) {
+	requests := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	from, to := FirstDayOfMonth(2024, time.March), FirstDayOfMonth(2024, time.February)
+	_, err := List[TimeReports](context.Background(), client, TimeReportFilter{FromDate: &from, ToDate: &to})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidFilter)
+	assert.Equal(t, "invalid TimeReportFilter: FromDate: from after to (allowed: a date on or before ToDate)", err.Error())
+	assert.Equal(t, 0, requests)
+}
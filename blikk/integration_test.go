@@ -3,6 +3,7 @@
 package blikk
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -22,18 +23,12 @@ func setupIntegrationTest(t *testing.T) *Client {
 		t.Skip("BLIKK_APP_ID and BLIKK_APP_SECRET must be set for integration tests")
 	}
 
-	token, err := GetAccessToken()
-	require.NoError(t, err, "Failed to get access token for integration test")
-	require.NotEmpty(t, token)
-
-	return NewClient(token)
+	return NewClient("", WithCredentials(appID, appSecret))
 }
 
 func TestIntegration_ListUsers(t *testing.T) {
 	client := setupIntegrationTest(t)
-	options := NewListOptions()
-
-	users, err := List[Users](client, options)
+	users, err := List[Users](context.Background(), client, UserFilter{})
 	require.NoError(t, err)
 	assert.NotEmpty(t, users, "Expected to find at least one user")
 	fmt.Printf("Found %d users\n", len(users))
@@ -41,12 +36,10 @@ func TestIntegration_ListUsers(t *testing.T) {
 
 func TestIntegration_ListTimeReportsForPreviousWeek(t *testing.T) {
 	client := setupIntegrationTest(t)
-	options := NewListOptions()
 	from, to := PreviousWeek()
-	options.FromDate = &from
-	options.ToDate = &to
+	filter := TimeReportFilter{FromDate: &from, ToDate: &to}
 
-	reports, err := List[TimeReports](client, options)
+	reports, err := List[TimeReports](context.Background(), client, filter)
 	require.NoError(t, err)
 	// It's okay if there are no reports, so we don't assert NotEmpty
 	fmt.Printf("Found %d time reports for previous week (%s to %s)\n", len(reports), from.Format(time.DateOnly), to.Format(time.DateOnly))
@@ -54,10 +47,8 @@ func TestIntegration_ListTimeReportsForPreviousWeek(t *testing.T) {
 
 func TestIntegration_GetUser(t *testing.T) {
 	client := setupIntegrationTest(t)
-	options := NewListOptions()
-
 	// First, list users to get a valid ID
-	users, err := List[Users](client, options)
+	users, err := List[Users](context.Background(), client, UserFilter{})
 	require.NoError(t, err)
 	require.NotEmpty(t, users, "Cannot test GetUser without at least one user to fetch")
 
@@ -66,7 +57,7 @@ func TestIntegration_GetUser(t *testing.T) {
 	userLastName := users[0].LastName
 
 	// Now, get the specific user
-	user, err := Get[User](client, fmt.Sprintf("%d", userID))
+	user, err := Get[User](context.Background(), client, fmt.Sprintf("%d", userID))
 	require.NoError(t, err)
 
 	assert.Equal(t, userID, user.ID)
@@ -74,3 +65,11 @@ func TestIntegration_GetUser(t *testing.T) {
 	assert.Equal(t, userLastName, user.LastName)
 	fmt.Printf("Successfully fetched user %s %s\n", user.FirstName, user.LastName)
 }
+
+func TestIntegration_GetAccessToken(t *testing.T) {
+	setupIntegrationTest(t)
+
+	token, err := GetAccessToken()
+	require.NoError(t, err, "Failed to get access token for integration test")
+	assert.NotEmpty(t, token)
+}
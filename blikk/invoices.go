@@ -0,0 +1,166 @@
+package blikk
+
+import "github.com/invenconlabs/blikk-sdk/dateutils"
+
+// InvoiceStatus is the payment status of an invoice.
+type InvoiceStatus string
+
+const (
+	InvoiceStatusUnpaid        InvoiceStatus = "Unpaid"
+	InvoiceStatusPartiallyPaid InvoiceStatus = "PartiallyPaid"
+	InvoiceStatusPaid          InvoiceStatus = "Paid"
+	InvoiceStatusOverdue       InvoiceStatus = "Overdue"
+	InvoiceStatusCredited      InvoiceStatus = "Credited"
+)
+
+// InvoiceRow is a line item on an invoice or invoice draft. Amount is the
+// row total excluding VAT, after discount.
+type InvoiceRow struct {
+	ObjectName      string      `json:"objectName"`
+	ID              int         `json:"id"`
+	Description     string      `json:"description"`
+	Article         blikkObject `json:"article"`
+	ArticleNumber   string      `json:"articleNumber"`
+	Quantity        float64     `json:"quantity"`
+	Unit            string      `json:"unit"`
+	UnitPrice       float64     `json:"unitPrice"`
+	DiscountPercent float64     `json:"discountPercent"`
+	VATPercent      float64     `json:"vatPercent"`
+	Amount          float64     `json:"amount"`
+	Project         ProjectRef  `json:"project"`
+	TimeReportIDs   []int       `json:"timeReportIds"`
+}
+
+type Invoices struct {
+	ObjectName        string             `json:"objectName"`
+	ID                int                `json:"id"`
+	InvoiceNumber     string             `json:"invoiceNumber"`
+	InvoiceDate       dateutils.DateOnly `json:"invoiceDate"`
+	DueDate           dateutils.DateOnly `json:"dueDate"`
+	Status            InvoiceStatus      `json:"status"`
+	Customer          blikkObject        `json:"customer"`
+	Project           ProjectRef         `json:"project"`
+	Currency          string             `json:"currency"`
+	TotalExcludingVAT float64            `json:"totalExcludingVat"`
+	VATAmount         float64            `json:"vatAmount"`
+	Total             float64            `json:"total"`
+	Balance           float64            `json:"balance"`
+	CreatedDate       string             `json:"createdDate"`
+	UpdatedDate       string             `json:"updatedDate"`
+}
+
+func (Invoices) path(InvoiceFilter) string {
+	return "v1/Core/Invoices"
+}
+
+// InvoiceFilter filters the invoices returned when listing Invoices.
+// FromDate and ToDate apply to the invoice date.
+type InvoiceFilter struct {
+	Pagination
+	FromDate    *dateutils.DateOnly `paramName:"filter.from"`
+	ToDate      *dateutils.DateOnly `paramName:"filter.to"`
+	ProjectIDs  []int               `paramName:"filter.projectIds"`
+	CustomerIDs []int               `paramName:"filter.customerIds"`
+	Status      InvoiceStatus       `paramName:"filter.status"`
+}
+
+func (f InvoiceFilter) Validate() error {
+	violations := f.Pagination.violations()
+	violations = append(violations, dateRangeViolations(f.FromDate, f.ToDate, 0)...)
+	violations = append(violations, unsupportedValueViolations("Status", f.Status,
+		InvoiceStatusUnpaid, InvoiceStatusPartiallyPaid, InvoiceStatusPaid, InvoiceStatusOverdue, InvoiceStatusCredited)...)
+	return newFilterError(f, violations)
+}
+
+// Invoice is the full detail of a single invoice, including its rows.
+type Invoice struct {
+	ObjectName        string             `json:"objectName"`
+	ID                int                `json:"id"`
+	InvoiceNumber     string             `json:"invoiceNumber"`
+	InvoiceDate       dateutils.DateOnly `json:"invoiceDate"`
+	DueDate           dateutils.DateOnly `json:"dueDate"`
+	Status            InvoiceStatus      `json:"status"`
+	Customer          blikkObject        `json:"customer"`
+	Project           ProjectRef         `json:"project"`
+	InvoiceAddress    Address            `json:"invoiceAddress"`
+	OurReference      string             `json:"ourReference"`
+	YourReference     string             `json:"yourReference"`
+	PaymentTermsDays  int                `json:"paymentTermsDays"`
+	Currency          string             `json:"currency"`
+	Rows              []InvoiceRow       `json:"rows"`
+	TotalExcludingVAT float64            `json:"totalExcludingVat"`
+	VATAmount         float64            `json:"vatAmount"`
+	RoundingAmount    float64            `json:"roundingAmount"`
+	Total             float64            `json:"total"`
+	Balance           float64            `json:"balance"`
+	SentDate          string             `json:"sentDate"`
+	PaidDate          string             `json:"paidDate"`
+	CreatedBy         blikkObject        `json:"createdBy"`
+	UpdatedBy         blikkObject        `json:"updatedBy"`
+	CreatedDate       string             `json:"createdDate"`
+	UpdatedDate       string             `json:"updatedDate"`
+}
+
+func (Invoice) path(query string) string {
+	return "v1/Core/Invoices/" + query
+}
+
+type InvoiceDrafts struct {
+	ObjectName        string             `json:"objectName"`
+	ID                int                `json:"id"`
+	InvoiceDate       dateutils.DateOnly `json:"invoiceDate"`
+	Customer          blikkObject        `json:"customer"`
+	Project           ProjectRef         `json:"project"`
+	Currency          string             `json:"currency"`
+	TotalExcludingVAT float64            `json:"totalExcludingVat"`
+	VATAmount         float64            `json:"vatAmount"`
+	Total             float64            `json:"total"`
+	CreatedDate       string             `json:"createdDate"`
+	UpdatedDate       string             `json:"updatedDate"`
+}
+
+func (InvoiceDrafts) path(InvoiceDraftFilter) string {
+	return "v1/Core/InvoiceDrafts"
+}
+
+// InvoiceDraftFilter filters the drafts returned when listing InvoiceDrafts.
+// FromDate and ToDate apply to the planned invoice date.
+type InvoiceDraftFilter struct {
+	Pagination
+	FromDate    *dateutils.DateOnly `paramName:"filter.from"`
+	ToDate      *dateutils.DateOnly `paramName:"filter.to"`
+	ProjectIDs  []int               `paramName:"filter.projectIds"`
+	CustomerIDs []int               `paramName:"filter.customerIds"`
+}
+
+func (f InvoiceDraftFilter) Validate() error {
+	violations := f.Pagination.violations()
+	violations = append(violations, dateRangeViolations(f.FromDate, f.ToDate, 0)...)
+	return newFilterError(f, violations)
+}
+
+// InvoiceDraft is the full detail of a single invoice draft, including its rows.
+type InvoiceDraft struct {
+	ObjectName        string             `json:"objectName"`
+	ID                int                `json:"id"`
+	InvoiceDate       dateutils.DateOnly `json:"invoiceDate"`
+	DueDate           dateutils.DateOnly `json:"dueDate"`
+	Customer          blikkObject        `json:"customer"`
+	Project           ProjectRef         `json:"project"`
+	InvoiceAddress    Address            `json:"invoiceAddress"`
+	OurReference      string             `json:"ourReference"`
+	YourReference     string             `json:"yourReference"`
+	Currency          string             `json:"currency"`
+	Rows              []InvoiceRow       `json:"rows"`
+	TotalExcludingVAT float64            `json:"totalExcludingVat"`
+	VATAmount         float64            `json:"vatAmount"`
+	Total             float64            `json:"total"`
+	CreatedBy         blikkObject        `json:"createdBy"`
+	UpdatedBy         blikkObject        `json:"updatedBy"`
+	CreatedDate       string             `json:"createdDate"`
+	UpdatedDate       string             `json:"updatedDate"`
+}
+
+func (InvoiceDraft) path(query string) string {
+	return "v1/Core/InvoiceDrafts/" + query
+}
@@ -0,0 +1,90 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList_InvoicesFilter(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/Invoices", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "2024-01-01", q.Get("filter.from"))
+		assert.Equal(t, "2024-01-31", q.Get("filter.to"))
+		assert.Equal(t, "42", q.Get("filter.projectIds"))
+		assert.Equal(t, "Unpaid", q.Get("filter.status"))
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": [
+			{"id": 7, "invoiceNumber": "1007", "invoiceDate": "2024-01-15", "dueDate": "2024-02-14", "status": "Unpaid", "total": 1250.0}
+		]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	from, to := FirstDayOfMonth(2024, time.January), LastDayOfMonth(2024, time.January)
+	invoices, err := List[Invoices](context.Background(), client, InvoiceFilter{
+		FromDate:   &from,
+		ToDate:     &to,
+		ProjectIDs: []int{42},
+		Status:     InvoiceStatusUnpaid,
+	})
+	require.NoError(t, err)
+	require.Len(t, invoices, 1)
+	assert.Equal(t, "2024-02-14", invoices[0].DueDate.Format(time.DateOnly))
+	assert.Equal(t, 1250.0, invoices[0].Total)
+}
+
+func TestInvoiceFilter_ValidateStatus(t *testing.T) {
+	err := InvoiceFilter{Status: "Draft"}.Validate()
+
+	var filterErr *FilterError
+	require.ErrorAs(t, err, &filterErr)
+	assert.Equal(t, "Status", filterErr.Violations[0].Field)
+	assert.Equal(t, RuleUnsupported, filterErr.Violations[0].Rule)
+	assert.Equal(t, "Unpaid, PartiallyPaid, Paid, Overdue, Credited", filterErr.Violations[0].Allowed)
+}
+
+func TestGet_InvoiceWithRows(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/Invoices/7", r.URL.Path)
+		fmt.Fprintln(w, `{
+			"id": 7, "status": "Paid", "customer": {"id": 3, "name": "Acme AB"},
+			"project": {"id": 42, "name": "Renovation", "number": "P-42"},
+			"rows": [{"description": "Snickeri", "quantity": 8, "unit": "h", "unitPrice": 500, "vatPercent": 25, "amount": 4000, "timeReportIds": [1, 2]}],
+			"totalExcludingVat": 4000, "vatAmount": 1000, "total": 5000
+		}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	invoice, err := Get[Invoice](context.Background(), client, "7")
+	require.NoError(t, err)
+	assert.Equal(t, InvoiceStatusPaid, invoice.Status)
+	assert.Equal(t, "P-42", invoice.Project.Number)
+	require.Len(t, invoice.Rows, 1)
+	assert.Equal(t, []int{1, 2}, invoice.Rows[0].TimeReportIDs)
+	assert.Equal(t, 1000.0, invoice.VATAmount)
+}
+
+func TestList_InvoiceDrafts(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/InvoiceDrafts", r.URL.Path)
+		assert.Equal(t, "42", r.URL.Query().Get("filter.projectIds"))
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": [{"id": 11, "total": 300}]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	drafts, err := List[InvoiceDrafts](context.Background(), client, InvoiceDraftFilter{ProjectIDs: []int{42}})
+	require.NoError(t, err)
+	require.Len(t, drafts, 1)
+	assert.Equal(t, 11, drafts[0].ID)
+}
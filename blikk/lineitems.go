@@ -0,0 +1,113 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// AdditionType is the kind of pay supplement an addition records.
+type AdditionType string
+
+const (
+	AdditionTypeOvertime      AdditionType = "Overtime"
+	AdditionTypeUnsocialHours AdditionType = "UnsocialHours"
+	AdditionTypeOnCall        AdditionType = "OnCall"
+	AdditionTypeOther         AdditionType = "Other"
+)
+
+// TimeReportAddition is a pay supplement on a time report, such as
+// overtime or an unsocial hours (OB) supplement. SalaryCode is the code the
+// addition maps to in the payroll system.
+type TimeReportAddition struct {
+	ObjectName string       `json:"objectName"`
+	ID         int          `json:"id"`
+	Type       AdditionType `json:"type"`
+	TimeCode   blikkObject  `json:"timeCode"`
+	Hours      float64      `json:"hours"`
+	Quantity   float64      `json:"quantity"`
+	Percent    float64      `json:"percent"`
+	Amount     float64      `json:"amount"`
+	SalaryCode string       `json:"salaryCode"`
+	Comment    string       `json:"comment"`
+}
+
+// TimeReportAdditions is the additions of a single time report. Get it with
+// the time report ID as query.
+type TimeReportAdditions []TimeReportAddition
+
+func (TimeReportAdditions) path(query string) string {
+	return "v1/Core/TimeReports/" + query + "/Additions"
+}
+
+// TimeReportEquipmentRow is the usage of a piece of equipment on a time
+// report. Price and Cost are per Unit, excluding VAT.
+type TimeReportEquipmentRow struct {
+	ObjectName    string      `json:"objectName"`
+	ID            int         `json:"id"`
+	Equipment     blikkObject `json:"equipment"`
+	Article       blikkObject `json:"article"`
+	Quantity      float64     `json:"quantity"`
+	Unit          string      `json:"unit"`
+	Price         float64     `json:"price"`
+	Cost          float64     `json:"cost"`
+	IsInvoiceable bool        `json:"isInvoiceable"`
+	Comment       string      `json:"comment"`
+}
+
+// TimeReportEquipment is the equipment rows of a single time report. Get it
+// with the time report ID as query.
+type TimeReportEquipment []TimeReportEquipmentRow
+
+func (TimeReportEquipment) path(query string) string {
+	return "v1/Core/TimeReports/" + query + "/Equipment"
+}
+
+// TimeReportLineItems holds the additions and equipment rows of a time
+// report.
+type TimeReportLineItems struct {
+	Additions TimeReportAdditions
+	Equipment TimeReportEquipment
+}
+
+// ListTimeReportLineItems loads the additions and equipment rows of reports,
+// such as the result of List[TimeReports], keyed by time report ID. Only
+// reports with HasAdditions or HasEquipment set are fetched, concurrently up
+// to the limit set with WithConcurrency.
+func ListTimeReportLineItems(ctx context.Context, c *Client, reports []TimeReports) (map[int]TimeReportLineItems, error) {
+	var pending []TimeReports
+	for _, report := range reports {
+		if report.HasAdditions || report.HasEquipment {
+			pending = append(pending, report)
+		}
+	}
+
+	results := make([]TimeReportLineItems, len(pending))
+	err := runConcurrently(ctx, c.concurrency, len(pending), func(ctx context.Context, i int) error {
+		id := strconv.Itoa(pending[i].ID)
+		if pending[i].HasAdditions {
+			additions, err := Get[TimeReportAdditions](ctx, c, id)
+			if err != nil {
+				return fmt.Errorf("failed to get additions for time report %s: %w", id, err)
+			}
+			results[i].Additions = additions
+		}
+		if pending[i].HasEquipment {
+			equipment, err := Get[TimeReportEquipment](ctx, c, id)
+			if err != nil {
+				return fmt.Errorf("failed to get equipment for time report %s: %w", id, err)
+			}
+			results[i].Equipment = equipment
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lineItems := make(map[int]TimeReportLineItems, len(pending))
+	for i, report := range pending {
+		lineItems[report.ID] = results[i]
+	}
+	return lineItems, nil
+}
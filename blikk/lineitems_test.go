@@ -0,0 +1,85 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet_TimeReportAdditions(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/TimeReports/55/Additions", r.URL.Path)
+		fmt.Fprintln(w, `[{"id": 1, "type": "Overtime", "hours": 2, "percent": 50, "salaryCode": "310"}]`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	additions, err := Get[TimeReportAdditions](context.Background(), client, "55")
+	require.NoError(t, err)
+	require.Len(t, additions, 1)
+	assert.Equal(t, AdditionTypeOvertime, additions[0].Type)
+	assert.Equal(t, "310", additions[0].SalaryCode)
+}
+
+func TestListTimeReportLineItems_FetchesFlaggedReports(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		requested []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requested = append(requested, r.URL.Path)
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/v1/Core/TimeReports/1/Additions":
+			fmt.Fprintln(w, `[{"id": 10, "type": "UnsocialHours", "hours": 3}]`)
+		case "/v1/Core/TimeReports/1/Equipment":
+			fmt.Fprintln(w, `[{"id": 20, "quantity": 4, "unit": "h"}]`)
+		case "/v1/Core/TimeReports/3/Equipment":
+			fmt.Fprintln(w, `[{"id": 30, "quantity": 1, "unit": "st"}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := NewClient("fake-token", WithBaseURL(server.URL+"/"), WithHTTPClient(server.Client()), WithConcurrency(3))
+
+	lineItems, err := ListTimeReportLineItems(context.Background(), client, []TimeReports{
+		{ID: 1, HasAdditions: true, HasEquipment: true},
+		{ID: 2},
+		{ID: 3, HasEquipment: true},
+	})
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{
+		"/v1/Core/TimeReports/1/Additions",
+		"/v1/Core/TimeReports/1/Equipment",
+		"/v1/Core/TimeReports/3/Equipment",
+	}, requested)
+	require.Len(t, lineItems, 2)
+	assert.Equal(t, AdditionTypeUnsocialHours, lineItems[1].Additions[0].Type)
+	assert.Equal(t, 4.0, lineItems[1].Equipment[0].Quantity)
+	assert.Nil(t, lineItems[3].Additions)
+	assert.Equal(t, "st", lineItems[3].Equipment[0].Unit)
+}
+
+func TestListTimeReportLineItems_Error(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	_, err := ListTimeReportLineItems(context.Background(), client, []TimeReports{{ID: 4, HasAdditions: true}})
+	require.ErrorIs(t, err, ErrNotFound)
+	assert.Contains(t, err.Error(), "time report 4")
+}
@@ -1,8 +1,6 @@
 package blikk
 
 import (
-	"time"
-
 	"github.com/invenconlabs/blikk-sdk/dateutils"
 )
 
@@ -12,36 +10,31 @@ type accessTokenResponse struct {
 	Expires     string `json:"expires"`
 }
 
-type ListOptions struct {
-	// Pagination
-	Page     int `paramName:"page"`
-	PageSize int `paramName:"pageSize"`
-
-	// Filtering
-	UserIDs  []uint16            `paramName:"filter.userIds"`
-	FromDate *dateutils.DateOnly `paramName:"filter.from"`
-	ToDate   *dateutils.DateOnly `paramName:"filter.to"`
-}
-
-func NewListOptions() ListOptions {
-	return ListOptions{
-		Page:     1,
-		PageSize: 100,
-	}
-}
-
 type blikkObject struct {
 	ObjectName string `json:"objectName"`
 	ID         int    `json:"id"`
 	Name       string `json:"name"`
 }
 
-type ListItem interface {
-	path() string
-	validFilter(options *ListOptions) bool
+// Address is a postal address.
+type Address struct {
+	ObjectName        string `json:"objectName"`
+	StreetAddress     string `json:"streetAddress"`
+	AdditionalAddress string `json:"additionalAddress"`
+	PostalCode        string `json:"postalCode"`
+	City              string `json:"city"`
+	State             string `json:"state"`
+	CountryID         int    `json:"countryId"`
+	CountryName       string `json:"countryName"`
 }
 
-type ListResponse[T ListItem] struct {
+// ListItem is implemented by resources that can be listed with a filter of
+// type F. Passing a filter of another type to List does not compile.
+type ListItem[F Filter] interface {
+	path(filter F) string
+}
+
+type ListResponse[T any] struct {
 	ObjectName     string `json:"objectName"`
 	Page           int    `json:"page"`
 	PageSize       int    `json:"pageSize"`
@@ -72,172 +65,364 @@ type Users struct {
 	CostCenter           blikkObject        `json:"costCenter"`
 }
 
-func (Users) path() string {
+func (Users) path(UserFilter) string {
 	return "v1/Admin/Users"
 }
 
-func (Users) validFilter(options *ListOptions) bool {
-	if len(options.UserIDs) > 0 ||
-		options.FromDate != nil ||
-		options.ToDate != nil {
-		return false
-	}
-	return true
+// UserFilter filters the users returned when listing Users.
+type UserFilter struct {
+	Pagination
+}
+
+func (f UserFilter) Validate() error {
+	return newFilterError(f, f.Pagination.violations())
 }
 
 type UserDayStatistics struct {
-	ObjectName string      `json:"objectName"`
-	UserID     uint16      `json:"userId"`
-	Name       string      `json:"name"`
-	Department blikkObject `json:"department"`
-	Dates      []struct {
-		ObjectName     string             `json:"objectName"`
-		Date           dateutils.DateOnly `json:"date"`
-		ReportedHours  float64            `json:"reportedHours"`
-		ScheduledHours float64            `json:"scheduledHours"`
-		LockedDate     string             `json:"lockedDate"`
-		AttestedDate   string             `json:"attestedDate"`
-	} `json:"dates"`
-}
-
-func (UserDayStatistics) path() string {
-	return "v1/Core/TimeReports/UserDayStatistics"
+	ObjectName string          `json:"objectName"`
+	UserID     uint16          `json:"userId"`
+	Name       string          `json:"name"`
+	Department blikkObject     `json:"department"`
+	Dates      []DayStatistics `json:"dates"`
 }
 
-func (UserDayStatistics) validFilter(options *ListOptions) bool {
-	if options.FromDate != nil && options.ToDate != nil {
-		if options.FromDate.After(options.ToDate.Time) {
-			return false
-		}
+// DayStatistics holds a user's reported and scheduled hours for one day.
+type DayStatistics struct {
+	ObjectName     string             `json:"objectName"`
+	Date           dateutils.DateOnly `json:"date"`
+	ReportedHours  float64            `json:"reportedHours"`
+	ScheduledHours float64            `json:"scheduledHours"`
+	LockedDate     string             `json:"lockedDate"`
+	AttestedDate   string             `json:"attestedDate"`
+}
+
+func (UserDayStatistics) path(UserDayStatisticsFilter) string {
+	return "v1/Core/TimeReports/UserDayStatistics"
+}
 
-		if options.ToDate.Sub(options.FromDate.Time) > 31*24*time.Hour {
-			return false
-		}
-	}
+// UserDayStatisticsFilter filters the statistics returned when listing
+// UserDayStatistics. The API accepts date ranges of at most 31 days.
+type UserDayStatisticsFilter struct {
+	Pagination
+	UserIDs       []int               `paramName:"filter.userIds"`
+	DepartmentIDs []int               `paramName:"filter.departmentIds"`
+	FromDate      *dateutils.DateOnly `paramName:"filter.from"`
+	ToDate        *dateutils.DateOnly `paramName:"filter.to"`
+}
 
-	return true
+func (f UserDayStatisticsFilter) Validate() error {
+	violations := f.Pagination.violations()
+	violations = append(violations, dateRangeViolations(f.FromDate, f.ToDate, maxUserDayStatisticsRange)...)
+	return newFilterError(f, violations)
 }
 
 type TimeReports struct {
-	ObjectName       string             `json:"objectName"`
-	ID               int                `json:"id"`
-	Date             dateutils.DateOnly `json:"date"`
-	ClockStart       string             `json:"clockStart"`
-	ClockEnd         string             `json:"clockEnd"`
-	Hours            float64            `json:"hours"`
-	InvoiceableHours float64            `json:"invoiceableHours"`
-	BreakMinutes     int                `json:"breakMinutes"`
-	Cost             float64            `json:"cost"`
-	Rate             float64            `json:"rate"`
-	Discount         float64            `json:"discount"`
-	Comment          string             `json:"comment"`
-	InternalComment  string             `json:"internalComment"`
-	SentToAttestDate string             `json:"sentToAttestDate"`
-	AttestedDate     string             `json:"attestedDate"`
-	User             blikkObject        `json:"user"`
-	Project          struct {
-		blikkObject
-		Number string `json:"number"`
-	} `json:"project"`
-	InternalProject   blikkObject `json:"internalProject"`
-	AbsenceProject    blikkObject `json:"absenceProject"`
-	Contact           blikkObject `json:"contact"`
-	Activity          blikkObject `json:"activity"`
-	TimeCode          blikkObject `json:"timeCode"`
-	TimeArticle       blikkObject `json:"timeArticle"`
-	CostCenter        blikkObject `json:"costCenter"`
-	InvoiceID         int         `json:"invoiceId"`
-	InvoicedDate      string      `json:"invoicedDate"`
-	InvoiceDraftID    int         `json:"invoiceDraftId"`
-	TravelReportID    int         `json:"travelReportId"`
-	AllowanceReportID int         `json:"allowanceReportId"`
-	CreatedDate       string      `json:"createdDate"`
-	UpdatedDate       string      `json:"updatedDate"`
-	HasAdditions      bool        `json:"hasAdditions"`
-	HasEquipment      bool        `json:"hasEquipment"`
-	CreatedBy         blikkObject `json:"createdBy"`
-	UpdatedBy         blikkObject `json:"updatedBy"`
-	TaskID            int         `json:"taskId"`
-}
-
-func (TimeReports) path() string {
+	ObjectName        string             `json:"objectName"`
+	ID                int                `json:"id"`
+	Date              dateutils.DateOnly `json:"date"`
+	ClockStart        string             `json:"clockStart"`
+	ClockEnd          string             `json:"clockEnd"`
+	Hours             float64            `json:"hours"`
+	InvoiceableHours  float64            `json:"invoiceableHours"`
+	BreakMinutes      int                `json:"breakMinutes"`
+	Cost              float64            `json:"cost"`
+	Rate              float64            `json:"rate"`
+	Discount          float64            `json:"discount"`
+	Comment           string             `json:"comment"`
+	InternalComment   string             `json:"internalComment"`
+	SentToAttestDate  string             `json:"sentToAttestDate"`
+	AttestedDate      string             `json:"attestedDate"`
+	User              blikkObject        `json:"user"`
+	Project           ProjectRef         `json:"project"`
+	InternalProject   blikkObject        `json:"internalProject"`
+	AbsenceProject    blikkObject        `json:"absenceProject"`
+	Contact           blikkObject        `json:"contact"`
+	Activity          blikkObject        `json:"activity"`
+	TimeCode          blikkObject        `json:"timeCode"`
+	TimeArticle       blikkObject        `json:"timeArticle"`
+	CostCenter        blikkObject        `json:"costCenter"`
+	InvoiceID         int                `json:"invoiceId"`
+	InvoicedDate      string             `json:"invoicedDate"`
+	InvoiceDraftID    int                `json:"invoiceDraftId"`
+	TravelReportID    int                `json:"travelReportId"`
+	AllowanceReportID int                `json:"allowanceReportId"`
+	CreatedDate       string             `json:"createdDate"`
+	UpdatedDate       string             `json:"updatedDate"`
+	HasAdditions      bool               `json:"hasAdditions"`
+	HasEquipment      bool               `json:"hasEquipment"`
+	CreatedBy         blikkObject        `json:"createdBy"`
+	UpdatedBy         blikkObject        `json:"updatedBy"`
+	TaskID            int                `json:"taskId"`
+}
+
+func (TimeReports) path(TimeReportFilter) string {
+	return "v1/Core/TimeReports"
+}
+
+// TimeReportFilter filters the time reports returned when listing TimeReports.
+// Nil pointer fields are not filtered on.
+type TimeReportFilter struct {
+	Pagination
+	UserIDs       []int               `paramName:"filter.userIds"`
+	FromDate      *dateutils.DateOnly `paramName:"filter.from"`
+	ToDate        *dateutils.DateOnly `paramName:"filter.to"`
+	ProjectIDs    []int               `paramName:"filter.projectIds"`
+	ActivityIDs   []int               `paramName:"filter.activityIds"`
+	CostCenterIDs []int               `paramName:"filter.costCenterIds"`
+	Attested      *bool               `paramName:"filter.isAttested"`
+	Invoiced      *bool               `paramName:"filter.isInvoiced"`
+}
+
+func (f TimeReportFilter) Validate() error {
+	violations := f.Pagination.violations()
+	violations = append(violations, dateRangeViolations(f.FromDate, f.ToDate, 0)...)
+	return newFilterError(f, violations)
+}
+
+func (TimeReports) createPath(TimeReportRequest) string {
 	return "v1/Core/TimeReports"
 }
 
-func (TimeReports) validFilter(options *ListOptions) bool {
-	if options.FromDate != nil && options.ToDate != nil {
-		if options.FromDate.After(options.ToDate.Time) {
-			return false
-		}
-	}
-	return true
+func (TimeReports) updatePath(id string, _ TimeReportRequest) string {
+	return "v1/Core/TimeReports/" + id
+}
+
+func (TimeReports) deletePath(id string) string {
+	return "v1/Core/TimeReports/" + id
+}
+
+// TimeReportRequest is the request body for creating or updating a time report.
+// A time report belongs to exactly one of a project, an internal project or
+// an absence project.
+type TimeReportRequest struct {
+	UserID            int                `json:"userId"`
+	Date              dateutils.DateOnly `json:"date"`
+	Hours             float64            `json:"hours"`
+	InvoiceableHours  *float64           `json:"invoiceableHours,omitempty"`
+	ClockStart        string             `json:"clockStart,omitempty"`
+	ClockEnd          string             `json:"clockEnd,omitempty"`
+	BreakMinutes      int                `json:"breakMinutes,omitempty"`
+	ProjectID         int                `json:"projectId,omitempty"`
+	InternalProjectID int                `json:"internalProjectId,omitempty"`
+	AbsenceProjectID  int                `json:"absenceProjectId,omitempty"`
+	ActivityID        int                `json:"activityId,omitempty"`
+	TimeCodeID        int                `json:"timeCodeId,omitempty"`
+	TimeArticleID     int                `json:"timeArticleId,omitempty"`
+	CostCenterID      int                `json:"costCenterId,omitempty"`
+	TaskID            int                `json:"taskId,omitempty"`
+	Comment           string             `json:"comment,omitempty"`
+	InternalComment   string             `json:"internalComment,omitempty"`
+}
+
+// ProjectStatus is the status of a project.
+type ProjectStatus struct {
+	blikkObject
+	IsCompletedStatus bool `json:"isCompletedStatus"`
+}
+
+// ProjectCategoryRef references the category of a project.
+type ProjectCategoryRef struct {
+	blikkObject
+	Color string `json:"color"`
+}
+
+// ProjectLocation is where the work on a project takes place.
+type ProjectLocation struct {
+	ObjectName    string  `json:"objectName"`
+	Longitude     float64 `json:"longitude"`
+	Latitude      float64 `json:"latitude"`
+	StreetAddress string  `json:"streetAddress"`
+	PostalCode    string  `json:"postalCode"`
+	City          string  `json:"city"`
+	CountryName   string  `json:"countryName"`
+}
+
+// ProjectRef references a project by ID, name and project number.
+type ProjectRef struct {
+	blikkObject
+	Number string `json:"number"`
+}
+
+// ProjectCollectionRef references the collection a project belongs to.
+type ProjectCollectionRef struct {
+	blikkObject
+	Number string `json:"number"`
+}
+
+// TagRef references a tag attached to a project.
+type TagRef struct {
+	ObjectName string `json:"objectName"`
+	ID         int    `json:"id"`
+	Title      string `json:"title"`
+	Color      string `json:"color"`
+}
+
+// CostCenterRef references a cost center.
+type CostCenterRef struct {
+	blikkObject
+	Code string `json:"code"`
 }
 
 type Projects struct {
-	ObjectName  string `json:"objectName"`
-	ID          int    `json:"id"`
-	OrderNumber string `json:"orderNumber"`
-	Title       string `json:"title"`
-	Status      struct {
-		blikkObject
-		IsCompletedStatus bool `json:"isCompletedStatus"`
-	} `json:"status"`
-	Category struct {
-		blikkObject
-		Color string `json:"color"`
-	} `json:"category"`
+	ObjectName        string               `json:"objectName"`
+	ID                int                  `json:"id"`
+	OrderNumber       string               `json:"orderNumber"`
+	Title             string               `json:"title"`
+	Status            ProjectStatus        `json:"status"`
+	Category          ProjectCategoryRef   `json:"category"`
+	SalesResponsible  blikkObject          `json:"salesResponsible"`
+	StartDate         dateutils.DateOnly   `json:"startDate"`
+	EndDate           dateutils.DateOnly   `json:"endDate"`
+	InvoiceType       string               `json:"invoiceType"`
+	Location          ProjectLocation      `json:"location"`
+	ProjectManager    blikkObject          `json:"projectManager"`
+	Customer          blikkObject          `json:"customer"`
+	ProjectCollection ProjectCollectionRef `json:"projectCollection"`
+	Tags              []TagRef             `json:"tags"`
+	CostCenter        CostCenterRef        `json:"costCenter"`
+	CreatedBy         blikkObject          `json:"createdBy"`
+	UpdatedBy         blikkObject          `json:"updatedBy"`
+	Created           string               `json:"created"`
+	Updated           string               `json:"updated"`
+}
+
+func (Projects) path(ProjectFilter) string {
+	return "v1/Core/Projects"
+}
+
+// ProjectFilter filters the projects returned when listing Projects.
+type ProjectFilter struct {
+	Pagination
+	// Query matches projects by free text, such as title or order number.
+	Query       string `paramName:"filter.query"`
+	StatusIDs   []int  `paramName:"filter.statusIds"`
+	CustomerIDs []int  `paramName:"filter.customerIds"`
+	CategoryIDs []int  `paramName:"filter.categoryIds"`
+	TagIDs      []int  `paramName:"filter.tagIds"`
+	// ProjectCollectionIDs matches projects in any of the given collections.
+	ProjectCollectionIDs []int `paramName:"filter.projectCollectionIds"`
+}
+
+func (f ProjectFilter) Validate() error {
+	return newFilterError(f, f.Pagination.violations())
+}
+
+func (Projects) createPath(ProjectRequest) string {
+	return "v1/Core/Projects"
+}
+
+func (Projects) updatePath(id string, _ ProjectRequest) string {
+	return "v1/Core/Projects/" + id
+}
+
+// ProjectRequest is the request body for creating or updating a project.
+type ProjectRequest struct {
+	Title               string              `json:"title"`
+	OrderNumber         string              `json:"orderNumber,omitempty"`
+	CustomerID          int                 `json:"customerId,omitempty"`
+	StatusID            int                 `json:"statusId,omitempty"`
+	CategoryID          int                 `json:"categoryId,omitempty"`
+	ProjectManagerID    int                 `json:"projectManagerId,omitempty"`
+	SalesResponsibleID  int                 `json:"salesResponsibleId,omitempty"`
+	ProjectCollectionID int                 `json:"projectCollectionId,omitempty"`
+	CostCenterID        int                 `json:"costCenterId,omitempty"`
+	StartDate           *dateutils.DateOnly `json:"startDate,omitempty"`
+	EndDate             *dateutils.DateOnly `json:"endDate,omitempty"`
+	InvoiceType         string              `json:"invoiceType,omitempty"`
+	TagIDs              []int               `json:"tagIds,omitempty"`
+}
+
+// Project is the full detail of a single project, including its budget,
+// contract sum and economy summary.
+type Project struct {
+	ObjectName       string             `json:"objectName"`
+	ID               int                `json:"id"`
+	OrderNumber      string             `json:"orderNumber"`
+	Title            string             `json:"title"`
+	Description      string             `json:"description"`
+	Status           ProjectStatus      `json:"status"`
+	Category         ProjectCategoryRef `json:"category"`
 	SalesResponsible blikkObject        `json:"salesResponsible"`
 	StartDate        dateutils.DateOnly `json:"startDate"`
 	EndDate          dateutils.DateOnly `json:"endDate"`
 	InvoiceType      string             `json:"invoiceType"`
-	Location         struct {
-		ObjectName    string  `json:"objectName"`
-		Longitude     float64 `json:"longitude"`
-		Latitude      float64 `json:"latitude"`
-		StreetAddress string  `json:"streetAddress"`
-		PostalCode    string  `json:"postalCode"`
-		City          string  `json:"city"`
-		CountryName   string  `json:"countryName"`
-	} `json:"location"`
-	ProjectManager    blikkObject `json:"projectManager"`
-	Customer          blikkObject `json:"customer"`
-	ProjectCollection struct {
-		blikkObject
-		Number string `json:"number"`
-	} `json:"projectCollection"`
-	Tags []struct {
+	Location         ProjectLocation    `json:"location"`
+	ProjectManager   blikkObject        `json:"projectManager"`
+	Customer         blikkObject        `json:"customer"`
+	Contacts         []struct {
+		ContactPerson
+		Role string `json:"role"`
+	} `json:"contacts"`
+	ProjectCollection ProjectCollectionRef `json:"projectCollection"`
+	Tags              []TagRef             `json:"tags"`
+	CostCenter        CostCenterRef        `json:"costCenter"`
+	Budget            struct {
+		ObjectName   string  `json:"objectName"`
+		Hours        float64 `json:"hours"`
+		TimeCost     float64 `json:"timeCost"`
+		MaterialCost float64 `json:"materialCost"`
+		TotalCost    float64 `json:"totalCost"`
+		Revenue      float64 `json:"revenue"`
+	} `json:"budget"`
+	ContractSum      float64 `json:"contractSum"`
+	ChangeOrdersSum  float64 `json:"changeOrdersSum"`
+	TotalContractSum float64 `json:"totalContractSum"`
+	Economy          struct {
+		ObjectName                string  `json:"objectName"`
+		ReportedHours             float64 `json:"reportedHours"`
+		InvoiceableHours          float64 `json:"invoiceableHours"`
+		TimeCost                  float64 `json:"timeCost"`
+		MaterialCost              float64 `json:"materialCost"`
+		OtherCost                 float64 `json:"otherCost"`
+		TotalCost                 float64 `json:"totalCost"`
+		InvoicedAmount            float64 `json:"invoicedAmount"`
+		NotInvoicedAmount         float64 `json:"notInvoicedAmount"`
+		Result                    float64 `json:"result"`
+		ContributionMarginPercent float64 `json:"contributionMarginPercent"`
+	} `json:"economy"`
+	CustomFields []struct {
 		ObjectName string `json:"objectName"`
 		ID         int    `json:"id"`
-		Title      string `json:"title"`
-		Color      string `json:"color"`
-	} `json:"tags"`
-	CostCenter struct {
-		blikkObject
-		Code string `json:"code"`
-	} `json:"costCenter"`
+		Name       string `json:"name"`
+		Value      string `json:"value"`
+	} `json:"customFields"`
 	CreatedBy blikkObject `json:"createdBy"`
 	UpdatedBy blikkObject `json:"updatedBy"`
 	Created   string      `json:"created"`
 	Updated   string      `json:"updated"`
 }
 
-func (Projects) path() string {
-	return "v1/Core/Projects"
-}
-
-func (Projects) validFilter(options *ListOptions) bool {
-	if len(options.UserIDs) > 0 ||
-		options.FromDate != nil ||
-		options.ToDate != nil {
-		return false
-	}
-	return true
+func (Project) path(query string) string {
+	return "v1/Core/Projects/" + query
 }
 
 type GetItem interface {
 	path(query string) string
 }
 
+// CreateItem is implemented by resources that can be created with a request
+// body of type B.
+type CreateItem[B any] interface {
+	createPath(body B) string
+}
+
+// UpdateItem is implemented by resources that can be replaced with a request
+// body of type B.
+type UpdateItem[B any] interface {
+	updatePath(id string, body B) string
+}
+
+// PatchItem is implemented by resources that can be partially updated with a
+// request body of type B.
+type PatchItem[B any] interface {
+	patchPath(id string, body B) string
+}
+
+// DeleteItem is implemented by resources that can be deleted.
+type DeleteItem interface {
+	deletePath(id string) string
+}
+
 type User struct {
 	ObjectName           string             `json:"objectName"`
 	ID                   int                `json:"id"`
@@ -289,3 +474,46 @@ type User struct {
 func (User) path(query string) string {
 	return "v1/Admin/Users/" + query
 }
+
+func (User) createPath(UserRequest) string {
+	return "v1/Admin/Users"
+}
+
+func (User) updatePath(id string, _ UserRequest) string {
+	return "v1/Admin/Users/" + id
+}
+
+func (User) patchPath(id string, _ UserPatchRequest) string {
+	return "v1/Admin/Users/" + id
+}
+
+// UserRequest is the request body for creating or updating a user.
+type UserRequest struct {
+	FirstName              string              `json:"firstName"`
+	LastName               string              `json:"lastName"`
+	Email                  string              `json:"email"`
+	License                string              `json:"license,omitempty"`
+	MobilePhoneNumber      string              `json:"mobilePhoneNumber,omitempty"`
+	WorkPhoneNumber        string              `json:"workPhoneNumber,omitempty"`
+	EmployeeNumber         string              `json:"employeeNumber,omitempty"`
+	StartDate              *dateutils.DateOnly `json:"startDate,omitempty"`
+	EndDate                *dateutils.DateOnly `json:"endDate,omitempty"`
+	DepartmentID           int                 `json:"departmentId,omitempty"`
+	CostCenterID           int                 `json:"costCenterId,omitempty"`
+	ManagerID              int                 `json:"managerId,omitempty"`
+	ScheduleID             int                 `json:"scheduleId,omitempty"`
+	TimeReportingProfileID int                 `json:"timeReportingProfileId,omitempty"`
+	CostPerHour            float64             `json:"costPerHour,omitempty"`
+}
+
+// UserPatchRequest is the request body for partially updating a user.
+// Only non-nil fields are sent. To deactivate a user, set Active to false
+// and optionally EndDate to their last working day.
+type UserPatchRequest struct {
+	Active       *bool               `json:"active,omitempty"`
+	EndDate      *dateutils.DateOnly `json:"endDate,omitempty"`
+	Email        *string             `json:"email,omitempty"`
+	DepartmentID *int                `json:"departmentId,omitempty"`
+	CostCenterID *int                `json:"costCenterId,omitempty"`
+	ManagerID    *int                `json:"managerId,omitempty"`
+}
@@ -0,0 +1,111 @@
+package blikk
+
+import "github.com/invenconlabs/blikk-sdk/dateutils"
+
+// OfferStatus is where an offer is in its lifecycle. Offers start as
+// drafts, are sent to the customer, and are then accepted or rejected.
+type OfferStatus string
+
+const (
+	OfferStatusDraft    OfferStatus = "Draft"
+	OfferStatusSent     OfferStatus = "Sent"
+	OfferStatusAccepted OfferStatus = "Accepted"
+	OfferStatusRejected OfferStatus = "Rejected"
+)
+
+// OfferRow is a line item on an offer. Amount is the row total excluding
+// VAT, after discount.
+type OfferRow struct {
+	ObjectName      string      `json:"objectName"`
+	ID              int         `json:"id"`
+	Description     string      `json:"description"`
+	Article         blikkObject `json:"article"`
+	ArticleNumber   string      `json:"articleNumber"`
+	Quantity        float64     `json:"quantity"`
+	Unit            string      `json:"unit"`
+	UnitPrice       float64     `json:"unitPrice"`
+	DiscountPercent float64     `json:"discountPercent"`
+	VATPercent      float64     `json:"vatPercent"`
+	Amount          float64     `json:"amount"`
+}
+
+// Offers is an offer as returned when listing offers. Project is set once an
+// accepted offer has been converted into a project.
+type Offers struct {
+	ObjectName        string             `json:"objectName"`
+	ID                int                `json:"id"`
+	OfferNumber       string             `json:"offerNumber"`
+	Title             string             `json:"title"`
+	Status            OfferStatus        `json:"status"`
+	OfferDate         dateutils.DateOnly `json:"offerDate"`
+	ValidUntil        dateutils.DateOnly `json:"validUntil"`
+	Customer          blikkObject        `json:"customer"`
+	Project           ProjectRef         `json:"project"`
+	SalesResponsible  blikkObject        `json:"salesResponsible"`
+	Currency          string             `json:"currency"`
+	TotalExcludingVAT float64            `json:"totalExcludingVat"`
+	VATAmount         float64            `json:"vatAmount"`
+	Total             float64            `json:"total"`
+	SentDate          string             `json:"sentDate"`
+	AcceptedDate      string             `json:"acceptedDate"`
+	RejectedDate      string             `json:"rejectedDate"`
+	CreatedDate       string             `json:"createdDate"`
+	UpdatedDate       string             `json:"updatedDate"`
+}
+
+func (Offers) path(OfferFilter) string {
+	return "v1/Core/Offers"
+}
+
+// OfferFilter filters the offers returned when listing Offers.
+// FromDate and ToDate apply to the offer date.
+type OfferFilter struct {
+	Pagination
+	FromDate    *dateutils.DateOnly `paramName:"filter.from"`
+	ToDate      *dateutils.DateOnly `paramName:"filter.to"`
+	CustomerIDs []int               `paramName:"filter.customerIds"`
+	ProjectIDs  []int               `paramName:"filter.projectIds"`
+	Status      OfferStatus         `paramName:"filter.status"`
+}
+
+func (f OfferFilter) Validate() error {
+	violations := f.Pagination.violations()
+	violations = append(violations, dateRangeViolations(f.FromDate, f.ToDate, 0)...)
+	violations = append(violations, unsupportedValueViolations("Status", f.Status,
+		OfferStatusDraft, OfferStatusSent, OfferStatusAccepted, OfferStatusRejected)...)
+	return newFilterError(f, violations)
+}
+
+// Offer is the full detail of a single offer, including its rows.
+type Offer struct {
+	ObjectName        string             `json:"objectName"`
+	ID                int                `json:"id"`
+	OfferNumber       string             `json:"offerNumber"`
+	Title             string             `json:"title"`
+	Description       string             `json:"description"`
+	Status            OfferStatus        `json:"status"`
+	OfferDate         dateutils.DateOnly `json:"offerDate"`
+	ValidUntil        dateutils.DateOnly `json:"validUntil"`
+	Customer          blikkObject        `json:"customer"`
+	Project           ProjectRef         `json:"project"`
+	SalesResponsible  blikkObject        `json:"salesResponsible"`
+	OurReference      string             `json:"ourReference"`
+	YourReference     string             `json:"yourReference"`
+	Currency          string             `json:"currency"`
+	Rows              []OfferRow         `json:"rows"`
+	TotalExcludingVAT float64            `json:"totalExcludingVat"`
+	VATAmount         float64            `json:"vatAmount"`
+	Total             float64            `json:"total"`
+	SentDate          string             `json:"sentDate"`
+	AcceptedDate      string             `json:"acceptedDate"`
+	RejectedDate      string             `json:"rejectedDate"`
+	RejectionReason   string             `json:"rejectionReason"`
+	CreatedBy         blikkObject        `json:"createdBy"`
+	UpdatedBy         blikkObject        `json:"updatedBy"`
+	CreatedDate       string             `json:"createdDate"`
+	UpdatedDate       string             `json:"updatedDate"`
+}
+
+func (Offer) path(query string) string {
+	return "v1/Core/Offers/" + query
+}
@@ -0,0 +1,65 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList_OffersFilter(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/Offers", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "2024-02-01", q.Get("filter.from"))
+		assert.Equal(t, "2024-02-29", q.Get("filter.to"))
+		assert.Equal(t, "Accepted", q.Get("filter.status"))
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": [
+			{"id": 15, "offerNumber": "O-15", "status": "Accepted", "validUntil": "2024-03-15", "project": {"id": 42, "number": "P-42"}, "acceptedDate": "2024-02-20T10:00:00Z"}
+		]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	from, to := FirstDayOfMonth(2024, time.February), LastDayOfMonth(2024, time.February)
+	offers, err := List[Offers](context.Background(), client, OfferFilter{FromDate: &from, ToDate: &to, Status: OfferStatusAccepted})
+	require.NoError(t, err)
+	require.Len(t, offers, 1)
+	assert.Equal(t, "2024-03-15", offers[0].ValidUntil.Format(time.DateOnly))
+	assert.Equal(t, 42, offers[0].Project.ID)
+	assert.Equal(t, "2024-02-20T10:00:00Z", offers[0].AcceptedDate)
+}
+
+func TestOfferFilter_ValidateStatus(t *testing.T) {
+	err := OfferFilter{Status: "Won"}.Validate()
+
+	var filterErr *FilterError
+	require.ErrorAs(t, err, &filterErr)
+	assert.Equal(t, []FilterViolation{{Field: "Status", Rule: RuleUnsupported, Allowed: "Draft, Sent, Accepted, Rejected"}}, filterErr.Violations)
+}
+
+func TestGet_OfferWithRows(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/Offers/15", r.URL.Path)
+		fmt.Fprintln(w, `{
+			"id": 15, "status": "Rejected", "rejectionReason": "Too expensive",
+			"rows": [{"description": "Altan", "quantity": 1, "unitPrice": 80000, "amount": 80000}],
+			"totalExcludingVat": 80000, "vatAmount": 20000, "total": 100000
+		}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	offer, err := Get[Offer](context.Background(), client, "15")
+	require.NoError(t, err)
+	assert.Equal(t, OfferStatusRejected, offer.Status)
+	assert.Equal(t, "Too expensive", offer.RejectionReason)
+	require.Len(t, offer.Rows, 1)
+	assert.Equal(t, 100000.0, offer.Total)
+}
@@ -0,0 +1,162 @@
+package blikk
+
+import "github.com/invenconlabs/blikk-sdk/dateutils"
+
+// TaskStatus is the progress of a task.
+type TaskStatus string
+
+const (
+	TaskStatusNotStarted TaskStatus = "NotStarted"
+	TaskStatusInProgress TaskStatus = "InProgress"
+	TaskStatusCompleted  TaskStatus = "Completed"
+)
+
+type Tasks struct {
+	ObjectName     string        `json:"objectName"`
+	ID             int           `json:"id"`
+	Title          string        `json:"title"`
+	Status         TaskStatus    `json:"status"`
+	Project        ProjectRef    `json:"project"`
+	AssignedUsers  []blikkObject `json:"assignedUsers"`
+	Start          string        `json:"start"`
+	End            string        `json:"end"`
+	EstimatedHours float64       `json:"estimatedHours"`
+	CreatedDate    string        `json:"createdDate"`
+	UpdatedDate    string        `json:"updatedDate"`
+}
+
+func (Tasks) path(TaskFilter) string {
+	return "v1/Core/Tasks"
+}
+
+// TaskFilter filters the tasks returned when listing Tasks. FromDate and
+// ToDate match tasks that overlap the range.
+type TaskFilter struct {
+	Pagination
+	ProjectIDs []int               `paramName:"filter.projectIds"`
+	UserIDs    []int               `paramName:"filter.userIds"`
+	FromDate   *dateutils.DateOnly `paramName:"filter.from"`
+	ToDate     *dateutils.DateOnly `paramName:"filter.to"`
+	Status     TaskStatus          `paramName:"filter.status"`
+}
+
+func (f TaskFilter) Validate() error {
+	violations := f.Pagination.violations()
+	violations = append(violations, dateRangeViolations(f.FromDate, f.ToDate, 0)...)
+	violations = append(violations, unsupportedValueViolations("Status", f.Status,
+		TaskStatusNotStarted, TaskStatusInProgress, TaskStatusCompleted)...)
+	return newFilterError(f, violations)
+}
+
+type Task struct {
+	ObjectName     string        `json:"objectName"`
+	ID             int           `json:"id"`
+	Title          string        `json:"title"`
+	Description    string        `json:"description"`
+	Status         TaskStatus    `json:"status"`
+	Project        ProjectRef    `json:"project"`
+	Activity       blikkObject   `json:"activity"`
+	AssignedUsers  []blikkObject `json:"assignedUsers"`
+	Start          string        `json:"start"`
+	End            string        `json:"end"`
+	EstimatedHours float64       `json:"estimatedHours"`
+	ReportedHours  float64       `json:"reportedHours"`
+	CreatedBy      blikkObject   `json:"createdBy"`
+	UpdatedBy      blikkObject   `json:"updatedBy"`
+	CreatedDate    string        `json:"createdDate"`
+	UpdatedDate    string        `json:"updatedDate"`
+}
+
+func (Task) path(query string) string {
+	return "v1/Core/Tasks/" + query
+}
+
+func (Task) createPath(TaskRequest) string {
+	return "v1/Core/Tasks"
+}
+
+func (Task) updatePath(id string, _ TaskRequest) string {
+	return "v1/Core/Tasks/" + id
+}
+
+// TaskRequest is the request body for creating or updating a task. Start and
+// End are date-times such as "2024-05-06T07:00:00".
+type TaskRequest struct {
+	Title           string     `json:"title"`
+	Description     string     `json:"description,omitempty"`
+	Status          TaskStatus `json:"status,omitempty"`
+	ProjectID       int        `json:"projectId"`
+	ActivityID      int        `json:"activityId,omitempty"`
+	AssignedUserIDs []int      `json:"assignedUserIds,omitempty"`
+	Start           string     `json:"start,omitempty"`
+	End             string     `json:"end,omitempty"`
+	EstimatedHours  *float64   `json:"estimatedHours,omitempty"`
+}
+
+// Bookings is planned work for a user as returned when listing bookings.
+// Bookings make up the planning calendar and count against the user's
+// planning capacity.
+type Bookings struct {
+	ObjectName  string      `json:"objectName"`
+	ID          int         `json:"id"`
+	User        blikkObject `json:"user"`
+	Project     ProjectRef  `json:"project"`
+	Task        blikkObject `json:"task"`
+	Start       string      `json:"start"`
+	End         string      `json:"end"`
+	Hours       float64     `json:"hours"`
+	Comment     string      `json:"comment"`
+	CreatedBy   blikkObject `json:"createdBy"`
+	UpdatedBy   blikkObject `json:"updatedBy"`
+	CreatedDate string      `json:"createdDate"`
+	UpdatedDate string      `json:"updatedDate"`
+}
+
+func (Bookings) path(BookingFilter) string {
+	return "v1/Core/Bookings"
+}
+
+// BookingFilter filters the bookings returned when listing Bookings.
+// FromDate and ToDate match bookings that overlap the range.
+type BookingFilter struct {
+	Pagination
+	UserIDs       []int               `paramName:"filter.userIds"`
+	DepartmentIDs []int               `paramName:"filter.departmentIds"`
+	ProjectIDs    []int               `paramName:"filter.projectIds"`
+	TaskIDs       []int               `paramName:"filter.taskIds"`
+	FromDate      *dateutils.DateOnly `paramName:"filter.from"`
+	ToDate        *dateutils.DateOnly `paramName:"filter.to"`
+}
+
+func (f BookingFilter) Validate() error {
+	violations := f.Pagination.violations()
+	violations = append(violations, dateRangeViolations(f.FromDate, f.ToDate, 0)...)
+	return newFilterError(f, violations)
+}
+
+type Booking Bookings
+
+func (Booking) path(query string) string {
+	return "v1/Core/Bookings/" + query
+}
+
+func (Booking) createPath(BookingRequest) string {
+	return "v1/Core/Bookings"
+}
+
+func (Booking) updatePath(id string, _ BookingRequest) string {
+	return "v1/Core/Bookings/" + id
+}
+
+// BookingRequest is the request body for creating or updating a booking.
+// Start and End are date-times such as "2024-05-06T07:00:00". If Hours is
+// nil, the booking covers the working hours between Start and End.
+type BookingRequest struct {
+	UserID    int      `json:"userId"`
+	ProjectID int      `json:"projectId,omitempty"`
+	TaskID    int      `json:"taskId,omitempty"`
+	Start     string   `json:"start"`
+	End       string   `json:"end"`
+	Hours     *float64 `json:"hours,omitempty"`
+	Comment   string   `json:"comment,omitempty"`
+}
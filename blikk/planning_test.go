@@ -0,0 +1,85 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList_TasksFilter(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/Tasks", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "42", q.Get("filter.projectIds"))
+		assert.Equal(t, "InProgress", q.Get("filter.status"))
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": [
+			{"id": 3, "title": "Rivning", "status": "InProgress", "assignedUsers": [{"id": 7}], "start": "2024-05-06T07:00:00", "end": "2024-05-08T16:00:00"}
+		]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	tasks, err := List[Tasks](context.Background(), client, TaskFilter{ProjectIDs: []int{42}, Status: TaskStatusInProgress})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, 7, tasks[0].AssignedUsers[0].ID)
+	assert.Equal(t, "2024-05-08T16:00:00", tasks[0].End)
+}
+
+func TestUpdate_Task(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/v1/Core/Tasks/3", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"title": "Rivning", "status": "Completed", "projectId": 42, "assignedUserIds": [7, 8]}`, string(body))
+
+		fmt.Fprintln(w, `{"id": 3, "title": "Rivning", "status": "Completed"}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	task, err := Update[Task](context.Background(), client, "3", TaskRequest{
+		Title:           "Rivning",
+		Status:          TaskStatusCompleted,
+		ProjectID:       42,
+		AssignedUserIDs: []int{7, 8},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, TaskStatusCompleted, task.Status)
+}
+
+func TestCreate_Booking(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/Core/Bookings", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"userId": 7, "projectId": 42, "start": "2024-05-06T07:00:00", "end": "2024-05-06T16:00:00"}`, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintln(w, `{"id": 12, "user": {"id": 7}, "hours": 8}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	booking, err := Create[Booking](context.Background(), client, BookingRequest{
+		UserID:    7,
+		ProjectID: 42,
+		Start:     "2024-05-06T07:00:00",
+		End:       "2024-05-06T16:00:00",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 12, booking.ID)
+	assert.Equal(t, 8.0, booking.Hours)
+}
@@ -0,0 +1,139 @@
+package blikk
+
+// ReferenceDataFilter filters the entries returned when listing reference
+// data catalogues such as Activities and TimeCodes.
+type ReferenceDataFilter struct {
+	Pagination
+	// Query matches entries by free text, such as name or code.
+	Query    string `paramName:"filter.query"`
+	IsActive *bool  `paramName:"filter.isActive"`
+}
+
+func (f ReferenceDataFilter) Validate() error {
+	return newFilterError(f, f.Pagination.violations())
+}
+
+type Activities struct {
+	ObjectName    string      `json:"objectName"`
+	ID            int         `json:"id"`
+	Name          string      `json:"name"`
+	Code          string      `json:"code"`
+	Description   string      `json:"description"`
+	IsActive      bool        `json:"isActive"`
+	IsInvoiceable bool        `json:"isInvoiceable"`
+	HourlyPrice   float64     `json:"hourlyPrice"`
+	HourlyCost    float64     `json:"hourlyCost"`
+	TimeArticle   blikkObject `json:"timeArticle"`
+	SalaryCode    string      `json:"salaryCode"`
+	CreatedDate   string      `json:"createdDate"`
+	UpdatedDate   string      `json:"updatedDate"`
+}
+
+func (Activities) path(ReferenceDataFilter) string {
+	return "v1/Core/Activities"
+}
+
+type Activity Activities
+
+func (Activity) path(query string) string {
+	return "v1/Core/Activities/" + query
+}
+
+// TimeCodes is a time code as returned when listing time codes. SalaryCode
+// is the code the time code maps to in the payroll system.
+type TimeCodes struct {
+	ObjectName      string  `json:"objectName"`
+	ID              int     `json:"id"`
+	Name            string  `json:"name"`
+	Code            string  `json:"code"`
+	Type            string  `json:"type"`
+	IsActive        bool    `json:"isActive"`
+	IsInvoiceable   bool    `json:"isInvoiceable"`
+	IsAbsence       bool    `json:"isAbsence"`
+	AffectsTimeBank bool    `json:"affectsTimeBank"`
+	SalaryCode      string  `json:"salaryCode"`
+	SalaryFactor    float64 `json:"salaryFactor"`
+	CreatedDate     string  `json:"createdDate"`
+	UpdatedDate     string  `json:"updatedDate"`
+}
+
+func (TimeCodes) path(ReferenceDataFilter) string {
+	return "v1/Core/TimeCodes"
+}
+
+type TimeCode TimeCodes
+
+func (TimeCode) path(query string) string {
+	return "v1/Core/TimeCodes/" + query
+}
+
+// TimeArticles is a time article as returned when listing time articles.
+// Price and Cost are per Unit, excluding VAT.
+type TimeArticles struct {
+	ObjectName    string  `json:"objectName"`
+	ID            int     `json:"id"`
+	Name          string  `json:"name"`
+	ArticleNumber string  `json:"articleNumber"`
+	Unit          string  `json:"unit"`
+	Price         float64 `json:"price"`
+	Cost          float64 `json:"cost"`
+	VATPercent    float64 `json:"vatPercent"`
+	IsActive      bool    `json:"isActive"`
+	IsInvoiceable bool    `json:"isInvoiceable"`
+	SalesAccount  string  `json:"salesAccount"`
+	SalaryCode    string  `json:"salaryCode"`
+	CreatedDate   string  `json:"createdDate"`
+	UpdatedDate   string  `json:"updatedDate"`
+}
+
+func (TimeArticles) path(ReferenceDataFilter) string {
+	return "v1/Core/TimeArticles"
+}
+
+type TimeArticle TimeArticles
+
+func (TimeArticle) path(query string) string {
+	return "v1/Core/TimeArticles/" + query
+}
+
+type CostCenters struct {
+	ObjectName  string `json:"objectName"`
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Code        string `json:"code"`
+	IsActive    bool   `json:"isActive"`
+	CreatedDate string `json:"createdDate"`
+	UpdatedDate string `json:"updatedDate"`
+}
+
+func (CostCenters) path(ReferenceDataFilter) string {
+	return "v1/Core/CostCenters"
+}
+
+type CostCenter CostCenters
+
+func (CostCenter) path(query string) string {
+	return "v1/Core/CostCenters/" + query
+}
+
+type Departments struct {
+	ObjectName  string      `json:"objectName"`
+	ID          int         `json:"id"`
+	Name        string      `json:"name"`
+	Code        string      `json:"code"`
+	IsActive    bool        `json:"isActive"`
+	Manager     blikkObject `json:"manager"`
+	CostCenter  blikkObject `json:"costCenter"`
+	CreatedDate string      `json:"createdDate"`
+	UpdatedDate string      `json:"updatedDate"`
+}
+
+func (Departments) path(ReferenceDataFilter) string {
+	return "v1/Admin/Departments"
+}
+
+type Department Departments
+
+func (Department) path(query string) string {
+	return "v1/Admin/Departments/" + query
+}
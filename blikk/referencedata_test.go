@@ -0,0 +1,65 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList_ActivitiesFilter(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/Activities", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "snick", q.Get("filter.query"))
+		assert.Equal(t, "true", q.Get("filter.isActive"))
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": [
+			{"id": 5, "name": "Snickeri", "code": "SN", "isActive": true, "hourlyPrice": 550, "salaryCode": "010"}
+		]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	isActive := true
+	activities, err := List[Activities](context.Background(), client, ReferenceDataFilter{Query: "snick", IsActive: &isActive})
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.Equal(t, "SN", activities[0].Code)
+	assert.Equal(t, 550.0, activities[0].HourlyPrice)
+	assert.Equal(t, "010", activities[0].SalaryCode)
+}
+
+func TestGet_TimeCode(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/TimeCodes/3", r.URL.Path)
+		fmt.Fprintln(w, `{"id": 3, "name": "Övertid 50%", "code": "OT50", "salaryCode": "310", "salaryFactor": 1.5, "affectsTimeBank": true}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	timeCode, err := Get[TimeCode](context.Background(), client, "3")
+	require.NoError(t, err)
+	assert.Equal(t, "310", timeCode.SalaryCode)
+	assert.Equal(t, 1.5, timeCode.SalaryFactor)
+	assert.True(t, timeCode.AffectsTimeBank)
+}
+
+func TestList_Departments(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Admin/Departments", r.URL.Path)
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": [{"id": 2, "name": "Bygg", "manager": {"id": 7, "name": "Anna Andersson"}}]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	departments, err := List[Departments](context.Background(), client, ReferenceDataFilter{})
+	require.NoError(t, err)
+	require.Len(t, departments, 1)
+	assert.Equal(t, 7, departments[0].Manager.ID)
+}
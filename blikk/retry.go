@@ -0,0 +1,214 @@
+package blikk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how the client retries requests that fail with a
+// transient error, such as a rate limit, a gateway error or a dropped
+// connection.
+//
+// Non-idempotent requests (POST and PATCH) are only retried when the API
+// signals that it did not process them, that is on 429 Too Many Requests and
+// 503 Service Unavailable, so that a write is never applied twice.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first.
+	// Zero means the number of attempts is not limited.
+	MaxAttempts int
+	// MaxElapsedTime bounds the total time spent on a request, including
+	// back-off. No retry is attempted if its wait would exceed the bound.
+	// Zero means no limit.
+	MaxElapsedTime time.Duration
+
+	// InitialBackoff is the wait before the first retry. Subsequent waits
+	// grow by Multiplier up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	// Jitter randomises each wait by up to the given fraction in either
+	// direction, e.g. 0.2 yields waits between 80% and 120% of the back-off.
+	Jitter float64
+
+	// RetryableStatusCodes lists the response status codes that are retried.
+	RetryableStatusCodes []int
+	// RetryableError reports whether a transport error is retried. Errors
+	// are never retried when it is nil.
+	RetryableError func(err error) bool
+	// MaxRetryAfter caps how long a Retry-After header may make the client
+	// wait before retrying. Zero means no cap.
+	MaxRetryAfter time.Duration
+}
+
+// DefaultRetryPolicy returns the policy used by clients created without
+// WithRetryPolicy. It makes up to five attempts within two minutes, retrying
+// rate limits, gateway errors and transient network errors.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    5,
+		MaxElapsedTime: 2 * time.Minute,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     30 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableError: IsTransientNetworkError,
+		MaxRetryAfter:  time.Minute,
+	}
+}
+
+// IsTransientNetworkError reports whether err is a network error that is
+// likely to succeed on retry: timeouts, refused or reset connections, and
+// connections closed before a response was received.
+func IsTransientNetworkError(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// retryableStatus reports whether a response with the given status code is
+// retried for a request with the given method.
+func (p RetryPolicy) retryableStatus(method string, statusCode int) bool {
+	if !slices.Contains(p.RetryableStatusCodes, statusCode) {
+		return false
+	}
+	if idempotent(method) {
+		return true
+	}
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+}
+
+// retryableError reports whether a transport error is retried for a request
+// with the given method.
+func (p RetryPolicy) retryableError(method string, err error) bool {
+	return idempotent(method) && p.RetryableError != nil && p.RetryableError(err)
+}
+
+func idempotent(method string) bool {
+	return method != http.MethodPost && method != http.MethodPatch
+}
+
+// backoff returns the wait before the given retry, starting at 1.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(retry-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		d *= 1 + p.Jitter*(2*rand.Float64()-1)
+	}
+	return time.Duration(d)
+}
+
+// retryAfter parses the Retry-After header of resp, which holds either a
+// number of seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+// retryRequest sends req, retrying it according to the client's retry policy.
+// A response with a status code that is not retryable is returned as is.
+// When the policy gives up, the last error is returned, annotated with the
+// number of attempts made. The wait between attempts is interrupted when the
+// request's context is done, in which case the context error is returned.
+func (c *Client) retryRequest(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	policy := c.retryPolicy
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req.Body = body
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			if !policy.retryableError(req.Method, err) {
+				return nil, err
+			}
+		} else if !policy.retryableStatus(req.Method, resp.StatusCode) {
+			return resp, nil
+		}
+
+		wait := policy.backoff(attempt)
+		if resp != nil {
+			if d, ok := retryAfter(resp); ok {
+				wait = d
+				if policy.MaxRetryAfter > 0 && wait > policy.MaxRetryAfter {
+					wait = policy.MaxRetryAfter
+				}
+			}
+		}
+
+		giveUp := (policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts) ||
+			(policy.MaxElapsedTime > 0 && time.Since(start)+wait > policy.MaxElapsedTime)
+		if giveUp {
+			if resp != nil {
+				err = newAPIError(resp)
+				resp.Body.Close()
+			}
+			if attempt == 1 {
+				return nil, err
+			}
+			return nil, fmt.Errorf("giving up after %d attempts: %w", attempt, err)
+		}
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// sleep pauses for d or until ctx is done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
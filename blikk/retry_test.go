@@ -0,0 +1,192 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fastRetryPolicy returns the default policy with back-off short enough for tests.
+func fastRetryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+	return policy
+}
+
+func TestRetryPolicy_RetriesGatewayErrors(t *testing.T) {
+	attempts := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprintln(w, `{"id": 1}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	client := NewClient("fake-token", WithBaseURL(server.URL+"/"), WithRetryPolicy(fastRetryPolicy()))
+
+	_, err := Get[User](context.Background(), client, "1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryPolicy_GivesUpAfterMaxAttempts(t *testing.T) {
+	attempts := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprintln(w, "bad gateway")
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	policy := fastRetryPolicy()
+	policy.MaxAttempts = 3
+	client := NewClient("fake-token", WithBaseURL(server.URL+"/"), WithRetryPolicy(policy))
+
+	_, err := Get[User](context.Background(), client, "1")
+	require.Error(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.ErrorIs(t, err, ErrServer)
+	assert.Contains(t, err.Error(), "giving up after 3 attempts")
+	assert.Contains(t, err.Error(), "bad gateway")
+}
+
+func TestRetryPolicy_DoesNotRetryOtherStatusCodes(t *testing.T) {
+	attempts := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	client := NewClient("fake-token", WithBaseURL(server.URL+"/"), WithRetryPolicy(fastRetryPolicy()))
+
+	_, err := Get[User](context.Background(), client, "1")
+	require.Error(t, err)
+	assert.Equal(t, 1, attempts)
+	assert.NotContains(t, err.Error(), "giving up")
+}
+
+func TestRetryPolicy_CapsRetryAfter(t *testing.T) {
+	attempts := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprintln(w, `{"id": 1}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	policy := fastRetryPolicy()
+	policy.MaxRetryAfter = 10 * time.Millisecond
+	client := NewClient("fake-token", WithBaseURL(server.URL+"/"), WithRetryPolicy(policy))
+
+	start := time.Now()
+	_, err := Get[User](context.Background(), client, "1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestRetryPolicy_MaxElapsedTime(t *testing.T) {
+	attempts := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	policy := fastRetryPolicy()
+	policy.MaxElapsedTime = 500 * time.Millisecond
+	client := NewClient("fake-token", WithBaseURL(server.URL+"/"), WithRetryPolicy(policy))
+
+	_, err := Get[User](context.Background(), client, "1")
+	require.Error(t, err)
+	assert.Equal(t, 1, attempts, "Expected no retry when the wait exceeds the elapsed time budget")
+	assert.ErrorIs(t, err, ErrRateLimited)
+}
+
+func TestRetryPolicy_RetriesConnectionErrors(t *testing.T) {
+	attempts := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			// Drop the connection without sending a response.
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err)
+			conn.Close()
+			return
+		}
+		fmt.Fprintln(w, `{"id": 1}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	client := NewClient("fake-token", WithBaseURL(server.URL+"/"), WithRetryPolicy(fastRetryPolicy()))
+
+	_, err := Get[User](context.Background(), client, "1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+	}
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.backoff(2))
+	assert.Equal(t, 400*time.Millisecond, policy.backoff(3))
+	assert.Equal(t, time.Second, policy.backoff(10))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := policy.backoff(1)
+		assert.GreaterOrEqual(t, d, 50*time.Millisecond)
+		assert.LessOrEqual(t, d, 150*time.Millisecond)
+	}
+}
+
+func TestRetryPolicy_ResendsBodyAfterRateLimit(t *testing.T) {
+	var bodies []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprintln(w, `{"id": 1}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	client := NewClient("fake-token", WithBaseURL(server.URL+"/"), WithRetryPolicy(fastRetryPolicy()))
+
+	_, err := Create[Projects](context.Background(), client, ProjectRequest{Title: "New"})
+	require.NoError(t, err)
+	require.Len(t, bodies, 2)
+	assert.Equal(t, bodies[0], bodies[1])
+	assert.JSONEq(t, `{"title": "New"}`, bodies[1])
+}
@@ -0,0 +1,113 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet_Schedule(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Admin/Schedules/1", r.URL.Path)
+		fmt.Fprintln(w, `{
+			"id": 1, "name": "Heltid 40h", "hoursPerWeek": 40,
+			"weeks": [{"weekNumber": 1, "hours": 40, "days": [{"weekday": "Monday", "hours": 8, "start": "07:00", "end": "16:00", "breakMinutes": 60}]}],
+			"holidays": [{"date": "2024-06-21", "name": "Midsommarafton", "hours": 0}]
+		}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	schedule, err := Get[Schedule](context.Background(), client, "1")
+	require.NoError(t, err)
+	assert.Equal(t, 40.0, schedule.HoursPerWeek)
+	require.Len(t, schedule.Weeks, 1)
+	assert.Equal(t, "07:00", schedule.Weeks[0].Days[0].Start)
+	require.Len(t, schedule.Holidays, 1)
+	assert.Equal(t, "2024-06-21", schedule.Holidays[0].Date.Format(time.DateOnly))
+}
+
+func TestGetTimeBankLedger(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/Admin/Users/7":
+			fmt.Fprintln(w, `{"id": 7, "timeBankEnabled": true, "currentTimeBank": 9}`)
+		case "/v1/Core/TimeBankTransactions":
+			q := r.URL.Query()
+			assert.Equal(t, "7", q.Get("filter.userIds"))
+			assert.Equal(t, "2024-05-01", q.Get("filter.from"))
+			assert.Empty(t, q.Get("filter.to"))
+			// Same-day transactions are returned out of order, and the
+			// last one is after the range.
+			fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": [
+				{"id": 3, "date": "2024-05-20", "type": "Withdrawal", "hours": -8},
+				{"id": 2, "date": "2024-05-02", "type": "Deposit", "hours": 2},
+				{"id": 1, "date": "2024-05-02", "type": "Deposit", "hours": 2},
+				{"id": 4, "date": "2024-06-03", "type": "Deposit", "hours": 3}
+			]}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	from, to := FirstDayOfMonth(2024, time.May), LastDayOfMonth(2024, time.May)
+	ledger, err := GetTimeBankLedger(context.Background(), client, 7, from, to)
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, ledger.OpeningBalance)
+	assert.Equal(t, 4.0, ledger.Deposits)
+	assert.Equal(t, 8.0, ledger.Withdrawals)
+	assert.Equal(t, 6.0, ledger.ClosingBalance)
+
+	var ids []int
+	for _, transaction := range ledger.Transactions {
+		ids = append(ids, transaction.ID)
+	}
+	assert.Equal(t, []int{1, 2, 3}, ids)
+}
+
+func TestGetTimeBankLedger_NoTransactions(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/Admin/Users/7" {
+			fmt.Fprintln(w, `{"id": 7, "timeBankEnabled": true, "currentTimeBank": 40}`)
+			return
+		}
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": []}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	from, to := FirstDayOfMonth(2024, time.May), LastDayOfMonth(2024, time.May)
+	ledger, err := GetTimeBankLedger(context.Background(), client, 7, from, to)
+	require.NoError(t, err)
+	assert.Empty(t, ledger.Transactions)
+	assert.Equal(t, 40.0, ledger.OpeningBalance)
+	assert.Equal(t, 40.0, ledger.ClosingBalance)
+}
+
+func TestGetTimeBankLedger_FromAfterTo(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	from, to := FirstDayOfMonth(2024, time.June), LastDayOfMonth(2024, time.May)
+	_, err := GetTimeBankLedger(context.Background(), client, 7, from, to)
+	require.ErrorIs(t, err, ErrInvalidFilter)
+
+	var filterErr *FilterError
+	require.ErrorAs(t, err, &filterErr)
+	assert.Equal(t, "TimeBankLedger", filterErr.Filter)
+	assert.Equal(t, RuleFromAfterTo, filterErr.Violations[0].Rule)
+}
@@ -0,0 +1,106 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// userDayStatisticsWindowDays is the number of days fetched per request by
+// ListUserDayStatistics, keeping each window within the API's 31-day limit.
+const userDayStatisticsWindowDays = 31
+
+// ListUserDayStatistics lists day statistics like List[UserDayStatistics],
+// but accepts a FromDate–ToDate range of any length. The range is split into
+// windows the API accepts, which are fetched concurrently up to the limit set
+// with WithConcurrency, and the results are merged into one
+// UserDayStatistics per user with its Dates sorted and free of duplicates.
+//
+// Filters without a date range are passed to List unchanged.
+func ListUserDayStatistics(ctx context.Context, c *Client, filter UserDayStatisticsFilter) ([]UserDayStatistics, error) {
+	if filter.FromDate == nil || filter.ToDate == nil || filter.FromDate.After(filter.ToDate.Time) {
+		return List[UserDayStatistics](ctx, c, filter)
+	}
+
+	windows := splitDateRange(*filter.FromDate, *filter.ToDate, userDayStatisticsWindowDays)
+	results := make([][]UserDayStatistics, len(windows))
+	err := runConcurrently(ctx, c.concurrency, len(windows), func(ctx context.Context, i int) error {
+		windowFilter := filter
+		// Every window starts at its own first page; a Page set by the
+		// caller would skip the first pages of each window.
+		windowFilter.Page = 0
+		windowFilter.FromDate = &windows[i][0]
+		windowFilter.ToDate = &windows[i][1]
+
+		// The windows already use the client's concurrency, so the pages
+		// of each window are fetched sequentially.
+		for page, err := range Pages[UserDayStatistics](ctx, c, windowFilter) {
+			if err != nil {
+				return fmt.Errorf("failed to list day statistics from %s to %s: %w",
+					windows[i][0].Format(time.DateOnly), windows[i][1].Format(time.DateOnly), err)
+			}
+			results[i] = append(results[i], page.Items...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeUserDayStatistics(results), nil
+}
+
+// splitDateRange splits the inclusive range from–to into consecutive
+// inclusive windows of at most days days.
+func splitDateRange(from, to DateOnly, days int) [][2]DateOnly {
+	var windows [][2]DateOnly
+	for start := from.Time; !start.After(to.Time); start = start.AddDate(0, 0, days) {
+		end := start.AddDate(0, 0, days-1)
+		if end.After(to.Time) {
+			end = to.Time
+		}
+		windows = append(windows, [2]DateOnly{{Time: start}, {Time: end}})
+	}
+	return windows
+}
+
+// mergeUserDayStatistics merges the statistics of several windows into one
+// entry per user, in order of first appearance, keeping the first entry seen
+// for each day and sorting the days by date.
+func mergeUserDayStatistics(windows [][]UserDayStatistics) []UserDayStatistics {
+	var merged []UserDayStatistics
+	index := make(map[uint16]int)
+	seen := make(map[uint16]map[string]bool)
+
+	for _, window := range windows {
+		for _, stats := range window {
+			i, ok := index[stats.UserID]
+			if !ok {
+				i = len(merged)
+				index[stats.UserID] = i
+				seen[stats.UserID] = make(map[string]bool)
+				entry := stats
+				entry.Dates = nil
+				merged = append(merged, entry)
+			}
+
+			for _, day := range stats.Dates {
+				key := day.Date.Format(time.DateOnly)
+				if seen[stats.UserID][key] {
+					continue
+				}
+				seen[stats.UserID][key] = true
+				merged[i].Dates = append(merged[i].Dates, day)
+			}
+		}
+	}
+
+	for i := range merged {
+		sort.Slice(merged[i].Dates, func(a, b int) bool {
+			return merged[i].Dates[a].Date.Before(merged[i].Dates[b].Date.Time)
+		})
+	}
+
+	return merged
+}
@@ -0,0 +1,122 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitDateRange(t *testing.T) {
+	from, to := FirstDayOfMonth(2024, time.January), LastDayOfMonth(2024, time.March)
+
+	windows := splitDateRange(from, to, userDayStatisticsWindowDays)
+	require.Len(t, windows, 3)
+
+	var got []string
+	for _, w := range windows {
+		got = append(got, w[0].Format(time.DateOnly)+".."+w[1].Format(time.DateOnly))
+		require.NoError(t, UserDayStatisticsFilter{FromDate: &w[0], ToDate: &w[1]}.Validate())
+	}
+	assert.Equal(t, []string{
+		"2024-01-01..2024-01-31",
+		"2024-02-01..2024-03-02",
+		"2024-03-03..2024-03-31",
+	}, got)
+
+	single := splitDateRange(from, from, userDayStatisticsWindowDays)
+	require.Len(t, single, 1)
+	assert.Equal(t, from, single[0][0])
+	assert.Equal(t, from, single[0][1])
+}
+
+func TestListUserDayStatistics_MergesWindows(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		windows []string
+	)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/TimeReports/UserDayStatistics", r.URL.Path)
+		from, to := r.URL.Query().Get("filter.from"), r.URL.Query().Get("filter.to")
+		mu.Lock()
+		windows = append(windows, from)
+		mu.Unlock()
+
+		// Every window reports its first and last day for user 1, and the
+		// last day again to check that duplicates are dropped. User 2 only
+		// appears in the first window.
+		users := fmt.Sprintf(`{"userId": 1, "name": "One", "dates": [{"date": %q}, {"date": %q}, {"date": %q}]}`, to, from, to)
+		if from == "2024-01-01" {
+			users += `, {"userId": 2, "name": "Two", "dates": [{"date": "2024-01-05"}]}`
+		}
+		fmt.Fprintf(w, `{"page": 1, "totalPages": 1, "items": [%s]}`, users)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	client := NewClient("fake-token", WithBaseURL(server.URL+"/"), WithHTTPClient(server.Client()), WithConcurrency(3))
+
+	from, to := FirstDayOfMonth(2024, time.January), LastDayOfMonth(2024, time.March)
+	stats, err := ListUserDayStatistics(context.Background(), client, UserDayStatisticsFilter{FromDate: &from, ToDate: &to})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"2024-01-01", "2024-02-01", "2024-03-03"}, windows)
+
+	require.Len(t, stats, 2)
+	assert.Equal(t, uint16(1), stats[0].UserID)
+	assert.Equal(t, "One", stats[0].Name)
+
+	var dates []string
+	for _, day := range stats[0].Dates {
+		dates = append(dates, day.Date.Format(time.DateOnly))
+	}
+	assert.Equal(t, []string{
+		"2024-01-01", "2024-01-31",
+		"2024-02-01", "2024-03-02",
+		"2024-03-03", "2024-03-31",
+	}, dates)
+
+	assert.Equal(t, uint16(2), stats[1].UserID)
+	assert.Len(t, stats[1].Dates, 1)
+}
+
+func TestListUserDayStatistics_StopsOnError(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	from, to := FirstDayOfMonth(2024, time.January), LastDayOfMonth(2024, time.June)
+	_, err := ListUserDayStatistics(context.Background(), client, UserDayStatisticsFilter{FromDate: &from, ToDate: &to})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.Contains(t, err.Error(), "from 2024-01-01 to 2024-01-31")
+}
+
+func TestListUserDayStatistics_IgnoresPage(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+		day := r.URL.Query().Get("filter.from")
+		fmt.Fprintf(w, `{"page": 1, "totalPages": 1, "items": [{"userId": 1, "dates": [{"date": %q}]}]}`, day)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	from, to := FirstDayOfMonth(2024, time.January), LastDayOfMonth(2024, time.February)
+	stats, err := ListUserDayStatistics(context.Background(), client, UserDayStatisticsFilter{
+		Pagination: Pagination{Page: 2},
+		FromDate:   &from,
+		ToDate:     &to,
+	})
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Len(t, stats[0].Dates, 2)
+}
@@ -0,0 +1,141 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TagType is the kind of resource a tag can be attached to.
+type TagType string
+
+const (
+	TagTypeProject TagType = "Project"
+	TagTypeUser    TagType = "User"
+)
+
+type Tags struct {
+	ObjectName string  `json:"objectName"`
+	ID         int     `json:"id"`
+	Title      string  `json:"title"`
+	Color      string  `json:"color"`
+	Type       TagType `json:"type"`
+}
+
+func (Tags) path(TagFilter) string {
+	return "v1/Core/Tags"
+}
+
+// TagFilter filters the tags returned when listing Tags.
+type TagFilter struct {
+	Pagination
+	// Query matches tags by title.
+	Query string  `paramName:"filter.query"`
+	Type  TagType `paramName:"filter.type"`
+}
+
+func (f TagFilter) Validate() error {
+	violations := f.Pagination.violations()
+	violations = append(violations, unsupportedValueViolations("Type", f.Type, TagTypeProject, TagTypeUser)...)
+	return newFilterError(f, violations)
+}
+
+type Tag Tags
+
+func (Tag) path(query string) string {
+	return "v1/Core/Tags/" + query
+}
+
+func (Tag) createPath(TagRequest) string {
+	return "v1/Core/Tags"
+}
+
+func (Tag) deletePath(id string) string {
+	return "v1/Core/Tags/" + id
+}
+
+// TagRequest is the request body for creating a tag. Color is a hex color
+// such as "#ff8800".
+type TagRequest struct {
+	Title string  `json:"title"`
+	Color string  `json:"color,omitempty"`
+	Type  TagType `json:"type"`
+}
+
+type ProjectCategories struct {
+	ObjectName string `json:"objectName"`
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Color      string `json:"color"`
+}
+
+func (ProjectCategories) path(ProjectCategoryFilter) string {
+	return "v1/Core/ProjectCategories"
+}
+
+// ProjectCategoryFilter filters the categories returned when listing
+// ProjectCategories.
+type ProjectCategoryFilter struct {
+	Pagination
+	// Query matches categories by name.
+	Query string `paramName:"filter.query"`
+}
+
+func (f ProjectCategoryFilter) Validate() error {
+	return newFilterError(f, f.Pagination.violations())
+}
+
+type ProjectCategory ProjectCategories
+
+func (ProjectCategory) path(query string) string {
+	return "v1/Core/ProjectCategories/" + query
+}
+
+func (ProjectCategory) createPath(ProjectCategoryRequest) string {
+	return "v1/Core/ProjectCategories"
+}
+
+func (ProjectCategory) deletePath(id string) string {
+	return "v1/Core/ProjectCategories/" + id
+}
+
+// ProjectCategoryRequest is the request body for creating a project
+// category. Color is a hex color such as "#ff8800".
+type ProjectCategoryRequest struct {
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"`
+}
+
+// AttachProjectTag attaches the tag identified by tagID to a project.
+// Attaching a tag the project already has is not an error.
+func AttachProjectTag(ctx context.Context, c *Client, projectID, tagID int) error {
+	return setTag(ctx, c, http.MethodPut, fmt.Sprintf("v1/Core/Projects/%d/Tags/%d", projectID, tagID))
+}
+
+// DetachProjectTag removes the tag identified by tagID from a project.
+func DetachProjectTag(ctx context.Context, c *Client, projectID, tagID int) error {
+	return setTag(ctx, c, http.MethodDelete, fmt.Sprintf("v1/Core/Projects/%d/Tags/%d", projectID, tagID))
+}
+
+// AttachUserTag attaches the tag identified by tagID to a user.
+// Attaching a tag the user already has is not an error.
+func AttachUserTag(ctx context.Context, c *Client, userID, tagID int) error {
+	return setTag(ctx, c, http.MethodPut, fmt.Sprintf("v1/Admin/Users/%d/Tags/%d", userID, tagID))
+}
+
+// DetachUserTag removes the tag identified by tagID from a user.
+func DetachUserTag(ctx context.Context, c *Client, userID, tagID int) error {
+	return setTag(ctx, c, http.MethodDelete, fmt.Sprintf("v1/Admin/Users/%d/Tags/%d", userID, tagID))
+}
+
+// setTag sends a bodyless attach or detach request to path.
+func setTag(ctx context.Context, c *Client, method, path string) error {
+	u, err := url.Parse(c.baseURL + path)
+	if err != nil {
+		return fmt.Errorf("invalid base URL: %w", err)
+	}
+
+	_, err = c.doRequest(ctx, method, u, nil)
+	return err
+}
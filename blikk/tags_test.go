@@ -0,0 +1,83 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate_Tag(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/Core/Tags", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"title": "ROT-eligible", "color": "#2e7d32", "type": "Project"}`, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintln(w, `{"id": 14, "title": "ROT-eligible", "color": "#2e7d32", "type": "Project"}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	tag, err := Create[Tag](context.Background(), client, TagRequest{Title: "ROT-eligible", Color: "#2e7d32", Type: TagTypeProject})
+	require.NoError(t, err)
+	assert.Equal(t, 14, tag.ID)
+}
+
+func TestDelete_ProjectCategory(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/v1/Core/ProjectCategories/5", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	err := Delete[ProjectCategory](context.Background(), client, "5")
+	require.NoError(t, err)
+}
+
+func TestAttachAndDetachTags(t *testing.T) {
+	var requests []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	ctx := context.Background()
+	require.NoError(t, AttachProjectTag(ctx, client, 42, 14))
+	require.NoError(t, DetachProjectTag(ctx, client, 42, 14))
+	require.NoError(t, AttachUserTag(ctx, client, 7, 15))
+	require.NoError(t, DetachUserTag(ctx, client, 7, 15))
+
+	assert.Equal(t, []string{
+		"PUT /v1/Core/Projects/42/Tags/14",
+		"DELETE /v1/Core/Projects/42/Tags/14",
+		"PUT /v1/Admin/Users/7/Tags/15",
+		"DELETE /v1/Admin/Users/7/Tags/15",
+	}, requests)
+}
+
+func TestAttachProjectTag_NotFound(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	err := AttachProjectTag(context.Background(), client, 42, 99)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
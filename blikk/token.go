@@ -0,0 +1,171 @@
+package blikk
+
+import (
+	"context"
+	b64 "encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// tokenExpiryDelta is how long before its expiry a token is considered stale,
+// so that it is refreshed ahead of time rather than rejected mid-request.
+const tokenExpiryDelta = time.Minute
+
+// Token is a Blikk API access token together with its expiry.
+type Token struct {
+	AccessToken string
+	// Expiry is the time the token expires. The zero value means the
+	// expiry is unknown and the token is used until the API rejects it.
+	Expiry time.Time
+}
+
+// Valid reports whether the token is non-empty and not about to expire.
+func (t *Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	if t.Expiry.IsZero() {
+		return true
+	}
+	return time.Now().Add(tokenExpiryDelta).Before(t.Expiry)
+}
+
+// TokenSource supplies access tokens to a Client.
+// Implementations must be safe for concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// tokenInvalidator is implemented by token sources that can discard a token
+// the API has rejected, so that the next call to Token fetches a new one.
+type tokenInvalidator interface {
+	invalidate(stale *Token)
+}
+
+type staticTokenSource struct {
+	token *Token
+}
+
+// StaticTokenSource returns a TokenSource that always returns the given
+// access token. It never refreshes, so requests fail once the token expires.
+func StaticTokenSource(accessToken string) TokenSource {
+	return staticTokenSource{token: &Token{AccessToken: accessToken}}
+}
+
+func (s staticTokenSource) Token(context.Context) (*Token, error) {
+	return s.token, nil
+}
+
+// credentialsTokenSource fetches tokens from the Blikk auth endpoint using an
+// app ID and secret. Tokens are fetched lazily, cached until shortly before
+// they expire, and concurrent refreshes are collapsed into a single request.
+type credentialsTokenSource struct {
+	client    *Client
+	appID     string
+	appSecret string
+
+	mu         sync.Mutex
+	token      *Token
+	refreshing chan struct{}
+}
+
+func (s *credentialsTokenSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	for {
+		if s.token.Valid() {
+			token := s.token
+			s.mu.Unlock()
+			return token, nil
+		}
+		if s.refreshing == nil {
+			break
+		}
+
+		// Another caller is already refreshing; wait for it to finish and
+		// check the cached token again.
+		done := s.refreshing
+		s.mu.Unlock()
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		s.mu.Lock()
+	}
+	done := make(chan struct{})
+	s.refreshing = done
+	s.mu.Unlock()
+
+	token, err := requestAccessToken(ctx, s.client.httpClient, s.client.baseURL, s.appID, s.appSecret)
+
+	s.mu.Lock()
+	if err == nil {
+		s.token = token
+	}
+	s.refreshing = nil
+	s.mu.Unlock()
+	close(done)
+
+	return token, err
+}
+
+func (s *credentialsTokenSource) invalidate(stale *Token) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == stale {
+		s.token = nil
+	}
+}
+
+// requestAccessToken exchanges an app ID and secret for an access token.
+func requestAccessToken(ctx context.Context, httpClient *http.Client, baseURL, appID, appSecret string) (*Token, error) {
+	encoded := b64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", appID, appSecret)))
+
+	req, err := http.NewRequestWithContext(ctx, "POST", baseURL+"v1/Auth/Token", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Basic "+encoded)
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(res)
+	}
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var accessTokenResponse accessTokenResponse
+	err = json.Unmarshal(body, &accessTokenResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Token{
+		AccessToken: accessTokenResponse.AccessToken,
+		Expiry:      parseTokenExpiry(accessTokenResponse.Expires),
+	}, nil
+}
+
+// parseTokenExpiry parses the expires field of a token response. An empty or
+// unrecognised value yields the zero time, meaning the expiry is unknown.
+func parseTokenExpiry(s string) time.Time {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,145 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupCredentialsServer creates a mock server whose token endpoint hands out
+// tokens named "token-1", "token-2", ... and a client using WithCredentials.
+// All other paths are served by handler.
+func setupCredentialsServer(t *testing.T, expires time.Duration, handler http.Handler) (*Client, *httptest.Server, *atomic.Int32) {
+	var issued atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/Auth/Token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		appID, appSecret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "app-id", appID)
+		assert.Equal(t, "app-secret", appSecret)
+
+		n := issued.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		fmt.Fprintf(w, `{"objectName": "accessToken", "accessToken": "token-%d", "expires": %q}`,
+			n, time.Now().Add(expires).UTC().Format(time.RFC3339))
+	})
+	mux.Handle("/", handler)
+
+	server := httptest.NewServer(mux)
+	client := NewClient("", WithBaseURL(server.URL+"/"), WithHTTPClient(server.Client()), WithCredentials("app-id", "app-secret"))
+	return client, server, &issued
+}
+
+func TestWithCredentials_ReusesTokenUntilExpiry(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token-1", r.Header.Get("Authorization"))
+		fmt.Fprintln(w, `{"id": 1}`)
+	})
+
+	client, server, issued := setupCredentialsServer(t, time.Hour, handler)
+	defer server.Close()
+
+	assert.Equal(t, int32(0), issued.Load(), "Expected the token to be fetched lazily")
+
+	for i := 0; i < 3; i++ {
+		_, err := Get[User](context.Background(), client, "1")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), issued.Load())
+}
+
+func TestWithCredentials_RefreshesAheadOfExpiry(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"id": 1}`)
+	})
+
+	// Tokens expiring within tokenExpiryDelta are never considered valid.
+	client, server, issued := setupCredentialsServer(t, tokenExpiryDelta/2, handler)
+	defer server.Close()
+
+	for i := 0; i < 2; i++ {
+		_, err := Get[User](context.Background(), client, "1")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), issued.Load())
+}
+
+func TestWithCredentials_RetriesOnceAfterUnauthorized(t *testing.T) {
+	var attempts atomic.Int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		if r.Header.Get("Authorization") == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprintln(w, `{"id": 1}`)
+	})
+
+	client, server, issued := setupCredentialsServer(t, time.Hour, handler)
+	defer server.Close()
+
+	_, err := Get[User](context.Background(), client, "1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), attempts.Load())
+	assert.Equal(t, int32(2), issued.Load())
+}
+
+func TestWithCredentials_DedupesConcurrentRefreshes(t *testing.T) {
+	client, server, issued := setupCredentialsServer(t, time.Hour, http.NotFoundHandler())
+	defer server.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := client.tokenSource.Token(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "token-1", token.AccessToken)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), issued.Load())
+}
+
+func TestWithCredentials_RejectedCredentials(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/Auth/Token", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, "invalid app secret")
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := NewClient("", WithBaseURL(server.URL+"/"), WithHTTPClient(server.Client()), WithCredentials("app-id", "wrong-secret"))
+
+	_, err := Get[User](context.Background(), client, "1")
+	require.Error(t, err)
+	assert.Equal(t, 1, strings.Count(err.Error(), "failed to get access token"))
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	assert.Equal(t, "invalid app secret", string(apiErr.Body))
+}
+
+func TestParseTokenExpiry(t *testing.T) {
+	assert.Equal(t, time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC), parseTokenExpiry("2024-05-01T12:30:00Z"))
+	assert.Equal(t, time.Date(2024, 5, 1, 12, 30, 0, 500000000, time.UTC), parseTokenExpiry("2024-05-01T12:30:00.5"))
+	assert.True(t, parseTokenExpiry("").IsZero())
+	assert.True(t, parseTokenExpiry("not a date").IsZero())
+}
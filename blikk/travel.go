@@ -0,0 +1,128 @@
+package blikk
+
+import "github.com/invenconlabs/blikk-sdk/dateutils"
+
+// VehicleType is the kind of vehicle a mileage trip was made with, which
+// determines the tax-free mileage allowance.
+type VehicleType string
+
+const (
+	VehicleTypePrivateCar         VehicleType = "PrivateCar"
+	VehicleTypeCompanyCar         VehicleType = "CompanyCar"
+	VehicleTypeCompanyCarElectric VehicleType = "CompanyCarElectric"
+)
+
+// AllowanceType is the kind of per-diem allowance.
+type AllowanceType string
+
+const (
+	AllowanceTypeWholeDay AllowanceType = "WholeDay"
+	AllowanceTypeHalfDay  AllowanceType = "HalfDay"
+	AllowanceTypeNight    AllowanceType = "Night"
+)
+
+// TravelReports is a mileage report as returned when listing travel reports.
+// TaxFreeAmount and TaxableAmount split the compensation into the tax-free
+// allowance and the part that is taxed as salary.
+type TravelReports struct {
+	ObjectName    string             `json:"objectName"`
+	ID            int                `json:"id"`
+	Date          dateutils.DateOnly `json:"date"`
+	Kilometers    float64            `json:"kilometers"`
+	VehicleType   VehicleType        `json:"vehicleType"`
+	FromAddress   string             `json:"fromAddress"`
+	ToAddress     string             `json:"toAddress"`
+	Purpose       string             `json:"purpose"`
+	User          blikkObject        `json:"user"`
+	Project       ProjectRef         `json:"project"`
+	TimeReportID  int                `json:"timeReportId"`
+	TaxFreeAmount float64            `json:"taxFreeAmount"`
+	TaxableAmount float64            `json:"taxableAmount"`
+	AttestedDate  string             `json:"attestedDate"`
+	IsLocked      bool               `json:"isLocked"`
+	CreatedBy     blikkObject        `json:"createdBy"`
+	UpdatedBy     blikkObject        `json:"updatedBy"`
+	CreatedDate   string             `json:"createdDate"`
+	UpdatedDate   string             `json:"updatedDate"`
+}
+
+func (TravelReports) path(TravelReportFilter) string {
+	return "v1/Core/TravelReports"
+}
+
+// TravelReportFilter filters the reports returned when listing
+// TravelReports. Nil pointer fields are not filtered on.
+type TravelReportFilter struct {
+	Pagination
+	UserIDs    []int               `paramName:"filter.userIds"`
+	ProjectIDs []int               `paramName:"filter.projectIds"`
+	FromDate   *dateutils.DateOnly `paramName:"filter.from"`
+	ToDate     *dateutils.DateOnly `paramName:"filter.to"`
+	Attested   *bool               `paramName:"filter.isAttested"`
+	Locked     *bool               `paramName:"filter.isLocked"`
+}
+
+func (f TravelReportFilter) Validate() error {
+	violations := f.Pagination.violations()
+	violations = append(violations, dateRangeViolations(f.FromDate, f.ToDate, 0)...)
+	return newFilterError(f, violations)
+}
+
+type TravelReport TravelReports
+
+func (TravelReport) path(query string) string {
+	return "v1/Core/TravelReports/" + query
+}
+
+// AllowanceReports is a per-diem allowance report as returned when listing
+// allowance reports. Free meals reduce the tax-free amount.
+type AllowanceReports struct {
+	ObjectName    string             `json:"objectName"`
+	ID            int                `json:"id"`
+	Date          dateutils.DateOnly `json:"date"`
+	Type          AllowanceType      `json:"type"`
+	IsDomestic    bool               `json:"isDomestic"`
+	CountryName   string             `json:"countryName"`
+	FreeBreakfast bool               `json:"freeBreakfast"`
+	FreeLunch     bool               `json:"freeLunch"`
+	FreeDinner    bool               `json:"freeDinner"`
+	User          blikkObject        `json:"user"`
+	Project       ProjectRef         `json:"project"`
+	TimeReportID  int                `json:"timeReportId"`
+	TaxFreeAmount float64            `json:"taxFreeAmount"`
+	TaxableAmount float64            `json:"taxableAmount"`
+	AttestedDate  string             `json:"attestedDate"`
+	IsLocked      bool               `json:"isLocked"`
+	CreatedBy     blikkObject        `json:"createdBy"`
+	UpdatedBy     blikkObject        `json:"updatedBy"`
+	CreatedDate   string             `json:"createdDate"`
+	UpdatedDate   string             `json:"updatedDate"`
+}
+
+func (AllowanceReports) path(AllowanceReportFilter) string {
+	return "v1/Core/AllowanceReports"
+}
+
+// AllowanceReportFilter filters the reports returned when listing
+// AllowanceReports. Nil pointer fields are not filtered on.
+type AllowanceReportFilter struct {
+	Pagination
+	UserIDs    []int               `paramName:"filter.userIds"`
+	ProjectIDs []int               `paramName:"filter.projectIds"`
+	FromDate   *dateutils.DateOnly `paramName:"filter.from"`
+	ToDate     *dateutils.DateOnly `paramName:"filter.to"`
+	Attested   *bool               `paramName:"filter.isAttested"`
+	Locked     *bool               `paramName:"filter.isLocked"`
+}
+
+func (f AllowanceReportFilter) Validate() error {
+	violations := f.Pagination.violations()
+	violations = append(violations, dateRangeViolations(f.FromDate, f.ToDate, 0)...)
+	return newFilterError(f, violations)
+}
+
+type AllowanceReport AllowanceReports
+
+func (AllowanceReport) path(query string) string {
+	return "v1/Core/AllowanceReports/" + query
+}
@@ -0,0 +1,70 @@
+package blikk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList_TravelReportsFilter(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/TravelReports", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "7", q.Get("filter.userIds"))
+		assert.Equal(t, "2024-03-01", q.Get("filter.from"))
+		assert.Equal(t, "2024-03-31", q.Get("filter.to"))
+		assert.Equal(t, "true", q.Get("filter.isAttested"))
+		assert.Empty(t, q.Get("filter.isLocked"))
+		fmt.Fprintln(w, `{"page": 1, "totalPages": 1, "items": [
+			{"id": 4, "date": "2024-03-12", "kilometers": 84, "vehicleType": "PrivateCar", "taxFreeAmount": 210, "taxableAmount": 42, "isLocked": true}
+		]}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	attested := true
+	from, to := FirstDayOfMonth(2024, time.March), LastDayOfMonth(2024, time.March)
+	reports, err := List[TravelReports](context.Background(), client, TravelReportFilter{
+		UserIDs:  []int{7},
+		FromDate: &from,
+		ToDate:   &to,
+		Attested: &attested,
+	})
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, VehicleTypePrivateCar, reports[0].VehicleType)
+	assert.Equal(t, 84.0, reports[0].Kilometers)
+	assert.Equal(t, 210.0, reports[0].TaxFreeAmount)
+	assert.True(t, reports[0].IsLocked)
+}
+
+func TestGet_AllowanceReport(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/Core/AllowanceReports/9", r.URL.Path)
+		fmt.Fprintln(w, `{"id": 9, "date": "2024-03-12", "type": "WholeDay", "isDomestic": true, "freeLunch": true, "taxFreeAmount": 182}`)
+	})
+
+	client, server := setupTestServer(t, handler)
+	defer server.Close()
+
+	report, err := Get[AllowanceReport](context.Background(), client, "9")
+	require.NoError(t, err)
+	assert.Equal(t, AllowanceTypeWholeDay, report.Type)
+	assert.True(t, report.FreeLunch)
+	assert.Equal(t, 182.0, report.TaxFreeAmount)
+}
+
+func TestAllowanceReportFilter_ValidateDateRange(t *testing.T) {
+	from, to := FirstDayOfMonth(2024, time.April), LastDayOfMonth(2024, time.March)
+	err := AllowanceReportFilter{FromDate: &from, ToDate: &to}.Validate()
+
+	var filterErr *FilterError
+	require.ErrorAs(t, err, &filterErr)
+	assert.Equal(t, RuleFromAfterTo, filterErr.Violations[0].Rule)
+}